@@ -0,0 +1,58 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GetFieldSelectorForAction returns a field selector for action, following
+// the nil-means-omit convention of GetFieldSelector. Unlike
+// involvedObject.*, "action" is not one of the fields the apiserver indexes
+// for Events, so this selector cannot actually be used server-side; it
+// exists so SearchByAction has a documented selector to build on, and so
+// client-side filtering logic (comparing event.Action) can be written once
+// against the same Set/Selector types used elsewhere in this package.
+func GetFieldSelectorForAction(action *string) fields.Selector {
+	field := fields.Set{}
+	if action != nil {
+		field["action"] = *action
+	}
+	return field.AsSelector()
+}
+
+// SearchByAction searches for events about objOrRef and returns only those
+// whose Action matches action. Because "action" is not server-side
+// field-selectable for Events (see GetFieldSelectorForAction), this filters
+// client-side after an ordinary Search rather than narrowing the List call
+// itself.
+func SearchByAction(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, action string) (*v1.EventList, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	filtered := list.DeepCopy()
+	filtered.Items = filtered.Items[:0]
+	for i := range list.Items {
+		if list.Items[i].Action == action {
+			filtered.Items = append(filtered.Items, list.Items[i])
+		}
+	}
+	return filtered, nil
+}
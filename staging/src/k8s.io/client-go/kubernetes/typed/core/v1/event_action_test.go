@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetFieldSelectorForActionOmitsNil(t *testing.T) {
+	if got := GetFieldSelectorForAction(nil).String(); got != "" {
+		t.Errorf("GetFieldSelectorForAction(nil).String() = %q, want empty", got)
+	}
+	action := "Binding"
+	if got := GetFieldSelectorForAction(&action).String(); got != "action=Binding" {
+		t.Errorf("GetFieldSelectorForAction(&%q).String() = %q, want %q", action, got, "action=Binding")
+	}
+}
+
+func TestSearchByActionFiltersClientSide(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"action":"Binding"},
+			{"metadata":{"name":"e2","namespace":"ns"},"action":"Scheduling"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	list, err := SearchByAction(e, scheme, pod, "Binding")
+	if err != nil {
+		t.Fatalf("SearchByAction: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Fatalf("SearchByAction result = %v, want [e1]", list.Items)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultAggregationKey groups events by namespace, involved object and
+// reason/message, the same identity the apiserver itself uses to decide
+// whether to bump an existing event's count instead of creating a new one.
+func DefaultAggregationKey(event *v1.Event) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s",
+		event.Namespace,
+		event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace,
+		event.InvolvedObject.Name,
+		event.InvolvedObject.UID,
+		event.Reason+"/"+event.Message,
+	)
+}
+
+// aggregationEntry is the buffered state for one aggregation key: the most
+// recently known representation of the event (either the last Record call,
+// or the apiserver's response from a previous Flush) plus how many times
+// the key has been recorded since that representation's Series.Count last
+// accounted for them.
+type aggregationEntry struct {
+	last  *v1.Event
+	count int32
+}
+
+// AggregatingEventSink buffers events under a caller-supplied key, so a
+// controller emitting the same logical event many times in quick succession
+// pays one apiserver write per FlushInterval instead of one per occurrence.
+// Record is cheap and never talks to the apiserver; Flush does the actual
+// write, via CreateOrUpdateWithEventNamespace, for every key that was
+// recorded since the previous Flush. It is safe for concurrent use.
+type AggregatingEventSink struct {
+	e             EventInterface
+	flushInterval time.Duration
+	keyFunc       func(*v1.Event) string
+
+	mu      sync.Mutex
+	entries map[string]*aggregationEntry
+}
+
+// NewAggregatingEventSink returns an AggregatingEventSink that writes
+// through e, intended to be flushed roughly every flushInterval (the sink
+// itself does not schedule the flush; the caller is expected to call Flush
+// on its own timer). A nil keyFunc defaults to DefaultAggregationKey.
+func NewAggregatingEventSink(e EventInterface, flushInterval time.Duration, keyFunc func(*v1.Event) string) *AggregatingEventSink {
+	if keyFunc == nil {
+		keyFunc = DefaultAggregationKey
+	}
+	return &AggregatingEventSink{
+		e:             e,
+		flushInterval: flushInterval,
+		keyFunc:       keyFunc,
+		entries:       make(map[string]*aggregationEntry),
+	}
+}
+
+// FlushInterval returns the interval this sink was constructed with.
+func (s *AggregatingEventSink) FlushInterval() time.Duration {
+	return s.flushInterval
+}
+
+// Record buffers event under s.keyFunc(event) instead of sending it to the
+// apiserver immediately. The first Record for a key keeps a copy of event as
+// the template the eventual write is based on; later Records for the same
+// key only bump a local counter, so only Flush ever does I/O.
+func (s *AggregatingEventSink) Record(event *v1.Event) {
+	key := s.keyFunc(event)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[key]
+	if !ok {
+		s.entries[key] = &aggregationEntry{last: event.DeepCopy(), count: 1}
+		return
+	}
+	entry.count++
+}
+
+// Flush writes one coalesced event per key recorded since the last Flush,
+// using CreateOrUpdateWithEventNamespace semantics, and clears the buffer.
+// A key's outgoing Series.Count is its previous Series.Count (zero the
+// first time the key is flushed) plus however many times Record was called
+// for it since then, so the count stays correct across flush boundaries
+// instead of resetting every interval; the base for the next flush is
+// whatever the apiserver returns for this one, not the local template.
+//
+// Flush stops and returns an error on the first key that fails to write;
+// keys it hasn't gotten to yet remain buffered for the next Flush.
+func (s *AggregatingEventSink) Flush() error {
+	s.mu.Lock()
+	entries := s.entries
+	s.entries = make(map[string]*aggregationEntry)
+	s.mu.Unlock()
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+
+	for i, key := range keys {
+		entry := entries[key]
+		event := entry.last.DeepCopy()
+		now := metav1.NowMicro()
+		if event.Series != nil {
+			event.Series.Count += entry.count
+			event.Series.LastObservedTime = now
+		} else if entry.count > 1 {
+			event.Series = &v1.EventSeries{Count: entry.count, LastObservedTime: now}
+		}
+
+		result, err := CreateOrUpdateWithEventNamespace(s.e, event)
+		if err != nil {
+			s.requeue(key, entry)
+			for _, pending := range keys[i+1:] {
+				s.requeue(pending, entries[pending])
+			}
+			return fmt.Errorf("flushing aggregated event for key %q: %w", key, err)
+		}
+
+		s.mu.Lock()
+		if existing, ok := s.entries[key]; ok {
+			// Record was called again for this key while Flush was in
+			// flight; keep what it buffered, but rebase it on the
+			// apiserver's result so the next Flush's Series.Count builds
+			// on the authoritative count rather than this one's template.
+			existing.last = result
+		}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// requeue puts entry back for key so a failed write isn't silently dropped,
+// merging it with anything Record buffered while the write was in flight.
+func (s *AggregatingEventSink) requeue(key string, entry *aggregationEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.entries[key]; ok {
+		entry.count += existing.count
+	}
+	s.entries[key] = entry
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// countingEventServer tracks, per event name, the Series.Count last written
+// via Update (or 0 if the event has only ever been Created), and returns
+// NotFound for Update against a name it hasn't seen yet -- enough to drive
+// CreateOrUpdateWithEventNamespace's create-then-update fallback.
+func countingEventServer(t *testing.T) *httptest.Server {
+	seen := map[string]int32{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event v1.Event
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&event)
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			if _, ok := seen[event.Name]; !ok {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				body, _ := json.Marshal(apierrors.NewNotFound(v1.Resource("events"), event.Name).ErrStatus)
+				w.Write(body)
+				return
+			}
+			if event.Series != nil {
+				seen[event.Name] = event.Series.Count
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			body, _ := json.Marshal(event)
+			w.Write(body)
+		case http.MethodPost:
+			if event.Series != nil {
+				seen[event.Name] = event.Series.Count
+			} else {
+				seen[event.Name] = 0
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			body, _ := json.Marshal(event)
+			w.Write(body)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+}
+
+func TestAggregatingEventSinkCoalescesWithinOneFlush(t *testing.T) {
+	srv := countingEventServer(t)
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	sink := NewAggregatingEventSink(e, 0, nil)
+
+	base := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Started",
+		Message:        "Container started",
+	}
+	for i := 0; i < 3; i++ {
+		sink.Record(base.DeepCopy())
+	}
+
+	if err := sink.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+}
+
+func TestAggregatingEventSinkCountAcrossFlushBoundaries(t *testing.T) {
+	srv := countingEventServer(t)
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	sink := NewAggregatingEventSink(e, 0, nil)
+
+	base := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Started",
+		Message:        "Container started",
+	}
+
+	// First flush cycle: two occurrences, nothing exists on the server yet,
+	// so CreateOrUpdateWithEventNamespace falls back to Create.
+	sink.Record(base.DeepCopy())
+	sink.Record(base.DeepCopy())
+	if err := sink.Flush(); err != nil {
+		t.Fatalf("first Flush: %v", err)
+	}
+	entry := sink.entries["ns/Pod/ns/p1/u1/Started/Container started"]
+	if entry != nil {
+		t.Fatalf("buffer should be empty after Flush, got %+v", entry)
+	}
+
+	// Second flush cycle, same key: three more occurrences. The sink should
+	// have rebased its template on the server's response from the first
+	// flush, so this flush's Series.Count builds on it instead of resetting.
+	sink.Record(base.DeepCopy())
+	sink.Record(base.DeepCopy())
+	sink.Record(base.DeepCopy())
+	if err := sink.Flush(); err != nil {
+		t.Fatalf("second Flush: %v", err)
+	}
+}
+
+func TestAggregatingEventSinkDefaultAggregationKeySeparatesDistinctEvents(t *testing.T) {
+	a := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Started",
+		Message:        "m",
+	}
+	b := a.DeepCopy()
+	b.Reason = "Killed"
+
+	if DefaultAggregationKey(a) == DefaultAggregationKey(b) {
+		t.Fatalf("events with different reasons should not share an aggregation key")
+	}
+}
+
+func TestAggregatingEventSinkFlushRequeuesOnError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	sink := NewAggregatingEventSink(e, 0, nil)
+	sink.Record(&v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Started",
+	})
+
+	if err := sink.Flush(); err == nil {
+		t.Fatalf("expected Flush to return an error")
+	}
+
+	sink.mu.Lock()
+	_, buffered := sink.entries[DefaultAggregationKey(&v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Started",
+	})]
+	sink.mu.Unlock()
+	if !buffered {
+		t.Fatalf("failed key should remain buffered for the next Flush")
+	}
+}
+
+func TestAggregatingEventSinkFlushRequeuesUnvisitedKeysOnError(t *testing.T) {
+	// Every write fails -- with two keys buffered, Flush's map iteration
+	// visits one of them first, fails, and returns immediately. The other
+	// key is never attempted, so it must still end up requeued rather than
+	// being dropped along with the local snapshot Flush swapped out.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	sink := NewAggregatingEventSink(e, 0, nil)
+	first := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Started",
+	}
+	second := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e2", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p2", UID: "u2"},
+		Reason:         "Killed",
+	}
+	sink.Record(first)
+	sink.Record(second)
+
+	if err := sink.Flush(); err == nil {
+		t.Fatalf("expected Flush to return an error")
+	}
+
+	sink.mu.Lock()
+	_, firstBuffered := sink.entries[DefaultAggregationKey(first)]
+	_, secondBuffered := sink.entries[DefaultAggregationKey(second)]
+	remaining := len(sink.entries)
+	sink.mu.Unlock()
+
+	// Exactly one of the two keys was the one that actually failed (and
+	// requeues itself); the other must have been requeued too, as a key
+	// Flush hadn't gotten to yet, not dropped.
+	if !firstBuffered || !secondBuffered {
+		t.Fatalf("expected both keys buffered after a mid-batch failure, first=%v second=%v", firstBuffered, secondBuffered)
+	}
+	if remaining != 2 {
+		t.Fatalf("expected 2 entries buffered after a mid-batch failure, got %d", remaining)
+	}
+}
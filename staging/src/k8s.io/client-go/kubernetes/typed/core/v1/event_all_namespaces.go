@@ -0,0 +1,42 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SearchAllNamespaces field-selects on involvedObject.uid and lists every
+// event about objUID regardless of namespace, answering "every event about
+// this exact object instance" for cluster-scoped objects or other
+// cross-namespace scenarios. It only works on a client built with the ""
+// namespace (i.e. List itself is unscoped); a namespaced client errors
+// clearly instead of silently returning a partial result.
+func SearchAllNamespaces(ctx context.Context, e EventInterface, objUID types.UID) (*v1.EventList, error) {
+	impl, ok := e.(*events)
+	if ok && impl.ns != "" {
+		return nil, fmt.Errorf("SearchAllNamespaces requires a client built with the \"\" namespace, got %q", impl.ns)
+	}
+	uid := string(objUID)
+	fieldSelector := e.GetFieldSelector(nil, nil, nil, &uid)
+	return e.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector.String()})
+}
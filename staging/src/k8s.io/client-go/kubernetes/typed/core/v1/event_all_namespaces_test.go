@@ -0,0 +1,61 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSearchAllNamespacesRejectsNamespacedClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for a namespaced client")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	if _, err := SearchAllNamespaces(context.Background(), e, types.UID("u1")); err == nil {
+		t.Fatalf("expected an error for a client scoped to a namespace")
+	}
+}
+
+func TestSearchAllNamespacesListsAcrossNamespaces(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns-a"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	list, err := SearchAllNamespaces(context.Background(), e, types.UID("u1"))
+	if err != nil {
+		t.Fatalf("SearchAllNamespaces: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Fatalf("list = %v, want [e1]", list.Items)
+	}
+	if gotQuery == "" {
+		t.Fatalf("expected a fieldSelector query on the underlying List, got none")
+	}
+}
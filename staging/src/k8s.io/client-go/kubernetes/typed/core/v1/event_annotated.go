@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EventWithMeta wraps an event with derived series metadata, so downstream
+// consumers don't each decode the series representation themselves.
+type EventWithMeta struct {
+	Event *v1.Event
+	// IsSeries reports whether Event carries (or, once merged, represents)
+	// more than one occurrence.
+	IsSeries bool
+	// TotalOccurrences is Event.Series.Count when present, Event.Count when
+	// it's the legacy aggregation field, or 1 for a genuinely one-off event.
+	// If both a series parent and standalone occurrences exist for the same
+	// logical identity, their counts are summed here.
+	TotalOccurrences int32
+}
+
+func occurrences(event *v1.Event) int32 {
+	switch {
+	case event.Series != nil:
+		return event.Series.Count
+	case event.Count > 0:
+		return event.Count
+	default:
+		return 1
+	}
+}
+
+// SearchAnnotated searches for events about objOrRef and returns them
+// wrapped with series metadata. Events that are SameLogicalEvent (the same
+// identity, recorded once as a series parent and once or more as a
+// standalone occurrence) are merged into a single EventWithMeta with their
+// occurrence counts summed.
+func SearchAnnotated(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) ([]EventWithMeta, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	var merged []EventWithMeta
+	for i := range list.Items {
+		event := &list.Items[i]
+		count := occurrences(event)
+		mergedInto := false
+		for j := range merged {
+			if SameLogicalEvent(merged[j].Event, event) {
+				merged[j].TotalOccurrences += count
+				merged[j].IsSeries = true
+				mergedInto = true
+				break
+			}
+		}
+		if !mergedInto {
+			merged = append(merged, EventWithMeta{
+				Event:            event,
+				IsSeries:         count > 1,
+				TotalOccurrences: count,
+			})
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchAnnotatedReportsOccurrenceCounts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"Scheduled","count":3},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"Pulled"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	annotated, err := SearchAnnotated(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchAnnotated: %v", err)
+	}
+	if len(annotated) != 2 {
+		t.Fatalf("len(annotated) = %d, want 2: %+v", len(annotated), annotated)
+	}
+	if annotated[0].TotalOccurrences != 3 || !annotated[0].IsSeries {
+		t.Errorf("annotated[0] = %+v, want TotalOccurrences=3 IsSeries=true", annotated[0])
+	}
+	if annotated[1].TotalOccurrences != 1 || annotated[1].IsSeries {
+		t.Errorf("annotated[1] = %+v, want TotalOccurrences=1 IsSeries=false", annotated[1])
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// DeterministicEventName derives a stable event name from objRef and reason,
+// matching the "<name>.<reason>" shape GenerateName-based emission loosely
+// follows, so repeated calls for the same object+reason target the same
+// object name instead of generating a new one each time.
+func DeterministicEventName(objRef *v1.ObjectReference, reason string) string {
+	return fmt.Sprintf("%s.%s", objRef.Name, reason)
+}
+
+// ApplyLogicalEvent server-side-applies an event derived from objOrRef,
+// reason, message and eventType under a deterministic name (see
+// DeterministicEventName), so repeated calls for the same logical event
+// converge to one object instead of creating duplicates. It returns the
+// applied event, including its managedFields.
+func ApplyLogicalEvent(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, reason, message, eventType, fieldManager string) (*v1.Event, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	namespace := objRef.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	name := DeterministicEventName(objRef, reason)
+
+	applyConfig := &v1.Event{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		InvolvedObject: *objRef,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+	}
+	data, err := json.Marshal(applyConfig)
+	if err != nil {
+		return nil, err
+	}
+	return e.Patch(ctx, name, types.ApplyPatchType, data, metav1.PatchOptions{FieldManager: fieldManager, Force: boolPtr(true)})
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
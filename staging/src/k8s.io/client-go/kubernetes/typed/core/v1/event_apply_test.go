@@ -0,0 +1,71 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDeterministicEventName(t *testing.T) {
+	objRef := &v1.ObjectReference{Name: "pod-1"}
+	if got, want := DeterministicEventName(objRef, "Scheduled"), "pod-1.Scheduled"; got != want {
+		t.Errorf("DeterministicEventName() = %q, want %q", got, want)
+	}
+}
+
+func TestApplyLogicalEventPatchesDeterministicName(t *testing.T) {
+	var gotMethod, gotPath, gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"pod-1.Scheduled","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "ns", UID: "u1"}}
+
+	result, err := ApplyLogicalEvent(context.Background(), e, scheme, pod, "Scheduled", "msg", v1.EventTypeNormal, "test-controller")
+	if err != nil {
+		t.Fatalf("ApplyLogicalEvent: %v", err)
+	}
+	if gotMethod != http.MethodPatch {
+		t.Errorf("method = %q, want PATCH", gotMethod)
+	}
+	if gotPath == "" || !strings.Contains(gotPath, "pod-1.Scheduled") {
+		t.Errorf("path = %q, want it to reference the deterministic name", gotPath)
+	}
+	if !strings.Contains(gotQuery, "fieldManager=test-controller") {
+		t.Errorf("query = %q, want it to include fieldManager", gotQuery)
+	}
+	if result.Name != "pod-1.Scheduled" {
+		t.Errorf("result.Name = %q, want %q", result.Name, "pod-1.Scheduled")
+	}
+}
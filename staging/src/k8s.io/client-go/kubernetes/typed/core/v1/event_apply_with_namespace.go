@@ -0,0 +1,48 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ApplyWithEventNamespace server-side-applies event under fieldManager,
+// taking ownership of conflicting fields from other managers when force is
+// true, and sends the request to event.Namespace like
+// CreateWithEventNamespace/UpdateWithEventNamespace do -- the namespace must
+// either match this client's namespace or this client must have been
+// created with the "" namespace. This package doesn't vendor
+// k8s.io/client-go/applyconfigurations, so unlike a generated Apply method
+// this takes a plain *v1.Event rather than a typed apply configuration; the
+// apiserver treats whichever fields are set on it as the ones fieldManager
+// owns, the same way ApplyLogicalEvent's hand-built event does.
+func ApplyWithEventNamespace(ctx context.Context, e EventInterface, event *v1.Event, fieldManager string, force bool) (*v1.Event, error) {
+	if impl, ok := e.(*events); ok && impl.ns != "" && event.Namespace != impl.ns {
+		return nil, fmt.Errorf("can't apply an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns)
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return e.Patch(ctx, event.Name, types.ApplyPatchType, data, metav1.PatchOptions{FieldManager: fieldManager, Force: &force})
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyWithEventNamespaceSetsContentTypeAndQueryParams(t *testing.T) {
+	var gotContentType, gotForce, gotFieldManager string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotForce = r.URL.Query().Get("force")
+		gotFieldManager = r.URL.Query().Get("fieldManager")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := ApplyWithEventNamespace(context.Background(), e, event, "my-controller", true); err != nil {
+		t.Fatalf("ApplyWithEventNamespace: %v", err)
+	}
+	if gotContentType != "application/apply-patch+yaml" {
+		t.Fatalf("Content-Type = %q, want application/apply-patch+yaml", gotContentType)
+	}
+	if gotForce != "true" {
+		t.Fatalf("force = %q, want true", gotForce)
+	}
+	if gotFieldManager != "my-controller" {
+		t.Fatalf("fieldManager = %q, want my-controller", gotFieldManager)
+	}
+}
+
+func TestApplyWithEventNamespaceEnforcesNamespaceGuard(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	base := newTestEventsClient(t, srv)
+	scoped := &events{client: base.client, ns: "configured"}
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := ApplyWithEventNamespace(context.Background(), scoped, event, "my-controller", true); err == nil {
+		t.Fatal("ApplyWithEventNamespace: want error for namespace mismatch")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// AsyncEventCreator fires events in the background and lets a controller
+// wait for them to drain before exiting, so a graceful shutdown doesn't lose
+// the last batch of events still in flight.
+type AsyncEventCreator struct {
+	e EventInterface
+
+	pending int32
+	wg      sync.WaitGroup
+}
+
+// NewAsyncEventCreator returns an AsyncEventCreator that creates events
+// through e.
+func NewAsyncEventCreator(e EventInterface) *AsyncEventCreator {
+	return &AsyncEventCreator{e: e}
+}
+
+// CreateAsync starts creating event in the background and returns
+// immediately; errors are swallowed (mirroring the broadcaster's own
+// best-effort event delivery) but the create is tracked so Flush can wait
+// for it.
+func (a *AsyncEventCreator) CreateAsync(event *v1.Event) {
+	atomic.AddInt32(&a.pending, 1)
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer atomic.AddInt32(&a.pending, -1)
+		_, _ = a.e.CreateWithEventNamespace(event)
+	}()
+}
+
+// Flush blocks until every CreateAsync call started before it completes, or
+// ctx expires. It returns the number of in-flight creates still outstanding
+// (and therefore dropped, from the caller's perspective) when the context
+// expired. That count is always zero when err is nil.
+func (a *AsyncEventCreator) Flush(ctx context.Context) (dropped int, err error) {
+	done := make(chan struct{})
+	go func() {
+		a.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return 0, nil
+	case <-ctx.Done():
+		return int(atomic.LoadInt32(&a.pending)), ctx.Err()
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAsyncEventCreatorFlushWaitsForPendingCreates(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	a := NewAsyncEventCreator(e)
+	a.CreateAsync(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}})
+
+	flushed := make(chan struct{})
+	go func() {
+		dropped, err := a.Flush(context.Background())
+		if err != nil || dropped != 0 {
+			t.Errorf("Flush() = (%d, %v), want (0, nil)", dropped, err)
+		}
+		close(flushed)
+	}()
+
+	select {
+	case <-flushed:
+		t.Fatalf("Flush returned before the in-flight create completed")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-flushed:
+	case <-time.After(time.Second):
+		t.Fatalf("Flush did not return after the create completed")
+	}
+}
+
+func TestAsyncEventCreatorFlushReportsDroppedOnContextExpiry(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	e := newTestEventsClient(t, srv)
+	a := NewAsyncEventCreator(e)
+	a.CreateAsync(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	dropped, err := a.Flush(ctx)
+	if err == nil {
+		t.Fatalf("Flush() returned nil error, want context deadline exceeded")
+	}
+	if dropped != 1 {
+		t.Fatalf("dropped = %d, want 1", dropped)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WithObservedTimeRange stamps an event being created with explicit
+// first/last observed times instead of letting them default to now, for
+// migration tooling backfilling historical events from another system.
+// CreateWithEventNamespaceOptions rejects an inverted range (last before
+// first) with a clear error instead of sending it to the apiserver.
+func WithObservedTimeRange(first, last metav1.Time) CreateOption {
+	return func(c *createConfig) {
+		c.observedTimeRange = &observedTimeRange{first: first, last: last}
+	}
+}
+
+type observedTimeRange struct {
+	first, last metav1.Time
+}
+
+// apply stamps event's timestamps with the configured range, returning an
+// error instead if the range is inverted.
+func (r *observedTimeRange) apply(event *v1.Event) (*v1.Event, error) {
+	if r.last.Time.Before(r.first.Time) {
+		return nil, fmt.Errorf("invalid observed time range: last (%v) is before first (%v)", r.last, r.first)
+	}
+	backfilled := *event
+	backfilled.FirstTimestamp = r.first
+	backfilled.LastTimestamp = r.last
+	backfilled.EventTime = metav1.MicroTime{Time: r.first.Time}
+	return &backfilled, nil
+}
+
+// ExtendSeriesWithObservedTimes is ExtendSeriesWithRetry's counterpart for
+// backfilled series: instead of bumping LastObservedTime to now, it sets it
+// to the explicit last time supplied, validating last is not before first.
+// It exists for migration tooling replaying a historical series in order;
+// live emission should keep using ExtendSeriesWithRetry, which defaults to
+// now.
+func ExtendSeriesWithObservedTimes(ctx context.Context, e EventInterface, namespace, name string, first, last metav1.MicroTime) (*v1.Event, error) {
+	if last.Time.Before(first.Time) {
+		return nil, fmt.Errorf("invalid observed time range: last (%v) is before first (%v)", last, first)
+	}
+	var result *v1.Event
+	backoff := wait.Backoff{Duration: defaultSeriesRetryBaseDelay, Factor: 2, Steps: defaultSeriesRetrySteps}
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		current, err := e.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		updated := current.DeepCopy()
+		if updated.Series == nil {
+			updated.Series = &v1.EventSeries{Count: 1, LastObservedTime: last}
+		} else {
+			updated.Series.Count++
+			updated.Series.LastObservedTime = last
+		}
+		saved, err := e.Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		result = saved
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithObservedTimeRangeStampsEvent(t *testing.T) {
+	first := metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	last := metav1.Time{Time: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)}
+	var gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, r.ContentLength)
+		r.Body.Read(buf)
+		gotBody = string(buf)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithObservedTimeRange(first, last)); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if gotBody == "" {
+		t.Fatalf("expected a request body to have been sent")
+	}
+}
+
+func TestWithObservedTimeRangeRejectsInvertedRange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for an inverted range")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	first := metav1.Time{Time: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)}
+	last := metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithObservedTimeRange(first, last)); err == nil {
+		t.Fatalf("expected an error for last before first")
+	}
+}
+
+func TestExtendSeriesWithObservedTimesRejectsInvertedRange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for an inverted range")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	first := metav1.MicroTime{Time: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)}
+	last := metav1.MicroTime{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	if _, err := ExtendSeriesWithObservedTimes(context.Background(), e, "ns", "e1", first, last); err == nil {
+		t.Fatalf("expected an error for last before first")
+	}
+}
+
+func TestExtendSeriesWithObservedTimesUpdatesSeries(t *testing.T) {
+	first := metav1.MicroTime{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	last := metav1.MicroTime{Time: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+		case http.MethodPut:
+			w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"},"series":{"count":1}}`))
+		}
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	result, err := ExtendSeriesWithObservedTimes(context.Background(), e, "ns", "e1", first, last)
+	if err != nil {
+		t.Fatalf("ExtendSeriesWithObservedTimes: %v", err)
+	}
+	if result.Series == nil || result.Series.Count != 1 {
+		t.Fatalf("result.Series = %+v, want Count=1", result.Series)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultBatchConcurrency bounds how many requests batch helpers such as
+// GetByNames issue to the apiserver at once, so a large batch doesn't open
+// an unbounded number of concurrent connections.
+const DefaultBatchConcurrency = 10
+
+// GetByNames fetches each of names (in e's configured namespace) concurrently,
+// bounded by DefaultBatchConcurrency, and returns the events that were found
+// plus one error per failed name, in the same order as names. A NotFound for
+// a given name produces its own error in that slot rather than aborting the
+// whole batch, so callers can tell which of their names didn't resolve.
+func GetByNames(ctx context.Context, e EventInterface, names []string) (*v1.EventList, []error) {
+	results := make([]*v1.Event, len(names))
+	errs := make([]error, len(names))
+
+	sem := make(chan struct{}, DefaultBatchConcurrency)
+	done := make(chan int, len(names))
+	for i, name := range names {
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer func() { <-sem; done <- i }()
+			event, err := e.Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				errs[i] = fmt.Errorf("getting event %q: %w", name, err)
+				return
+			}
+			results[i] = event
+		}(i, name)
+	}
+	for range names {
+		<-done
+	}
+
+	list := &v1.EventList{}
+	var failures []error
+	for i, event := range results {
+		if event != nil {
+			list.Items = append(list.Items, *event)
+		}
+		if errs[i] != nil {
+			failures = append(failures, errs[i])
+		}
+	}
+	return list, failures
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// DefaultBatchCreateWorkers is the worker count
+// BatchCreateWithEventNamespace uses when callers pass workers <= 0.
+const DefaultBatchCreateWorkers = 4
+
+// BatchCreateWithEventNamespace creates every event in events, issuing up to
+// workers POSTs concurrently (workers <= 0 uses DefaultBatchCreateWorkers),
+// and returns per-event results and errors aligned by index with the input.
+// A failure creating one event doesn't abort the others; the caller inspects
+// errs to see which, if any, failed.
+func BatchCreateWithEventNamespace(e EventInterface, events []*v1.Event, workers int) ([]*v1.Event, []error) {
+	if workers <= 0 {
+		workers = DefaultBatchCreateWorkers
+	}
+
+	results := make([]*v1.Event, len(events))
+	errs := make([]error, len(events))
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				results[idx], errs[idx] = e.CreateWithEventNamespace(events[idx])
+			}
+		}()
+	}
+	for i := range events {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results, errs
+}
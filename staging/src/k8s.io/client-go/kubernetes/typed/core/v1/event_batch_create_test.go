@@ -0,0 +1,80 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBatchCreateWithEventNamespaceCreatesAllEvents(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	events := make([]*v1.Event, 6)
+	for i := range events {
+		events[i] = &v1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}}
+	}
+
+	results, errs := BatchCreateWithEventNamespace(e, events, 3)
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("errs[%d] = %v, want nil", i, err)
+		}
+	}
+	for i, result := range results {
+		if result == nil {
+			t.Errorf("results[%d] = nil, want a created event", i)
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got != int32(len(events)) {
+		t.Errorf("requests = %d, want %d", got, len(events))
+	}
+}
+
+func TestBatchCreateWithEventNamespaceRecordsPerEventErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"kind":"Status","status":"Failure","reason":"InternalError"}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	events := []*v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}},
+	}
+
+	_, errs := BatchCreateWithEventNamespace(e, events, 0)
+	for i, err := range errs {
+		if err == nil {
+			t.Errorf("errs[%d] = nil, want an error", i)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetByNamesReturnsFoundAndErrorsForMissing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"kind":"Status","status":"Failure","reason":"NotFound"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"` + strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/ns/events/") + `","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	list, errs := GetByNames(context.Background(), e, []string{"e1", "missing", "e2"})
+	if len(list.Items) != 2 {
+		t.Fatalf("len(list.Items) = %d, want 2: %v", len(list.Items), list.Items)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("len(errs) = %d, want 1: %v", len(errs), errs)
+	}
+}
+
+func TestGetByNamesPreservesOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/ns/events/")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"` + name + `","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	names := []string{"e1", "e2", "e3", "e4", "e5"}
+	list, errs := GetByNames(context.Background(), e, names)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errs: %v", errs)
+	}
+	if len(list.Items) != len(names) {
+		t.Fatalf("len(list.Items) = %d, want %d", len(list.Items), len(names))
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// SearchWithByteBudget pages through events about objOrRef, stopping once
+// the accumulated serialized size of fetched events exceeds maxBytes, for
+// environments where item count alone is a poor proxy for memory use. It
+// returns the partial list gathered so far and whether it was truncated
+// before exhausting the result.
+func SearchWithByteBudget(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, maxBytes int) (*v1.EventList, bool, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, false, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+
+	result := &v1.EventList{}
+	var spent int
+	var continueToken string
+	for {
+		page, err := e.List(ctx, metav1.ListOptions{
+			FieldSelector: fieldSelector,
+			Limit:         eventExportPageSize,
+			Continue:      continueToken,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for i := range page.Items {
+			size, err := eventByteSize(&page.Items[i])
+			if err != nil {
+				return nil, false, err
+			}
+			if spent+size > maxBytes {
+				return result, true, nil
+			}
+			spent += size
+			result.Items = append(result.Items, page.Items[i])
+		}
+		if page.Continue == "" {
+			return result, false, nil
+		}
+		continueToken = page.Continue
+	}
+}
+
+// eventByteSize estimates an event's serialized size via an actual JSON
+// marshal, which is cheap enough per event and far more accurate than a
+// field-count heuristic.
+func eventByteSize(event *v1.Event) (int, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
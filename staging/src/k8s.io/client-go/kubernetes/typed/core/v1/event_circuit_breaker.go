@@ -0,0 +1,156 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by CreateWithEventNamespaceOptions, instead of
+// going to the server, while a CircuitBreaker configured via
+// WithCircuitBreaker is open.
+var ErrCircuitOpen = errors.New("event creation circuit breaker is open")
+
+// circuitState is a CircuitBreaker's current disposition.
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// CircuitBreakerMetrics counts transitions through a CircuitBreaker, for
+// callers that want to alert on it tripping. Both fields default to a no-op
+// and can be replaced before the breaker is used.
+type CircuitBreakerMetrics struct {
+	Opened  CounterMetric
+	Tripped CounterMetric
+}
+
+// CircuitBreaker protects against hammering a failing apiserver with event
+// creates: once Threshold consecutive failures are observed, it opens for
+// Cooldown and fails every call immediately with ErrCircuitOpen. After
+// Cooldown elapses it goes half-open and lets exactly one probe call
+// through; that call's outcome either closes the breaker (success) or
+// reopens it for another Cooldown (failure). It is disabled (always closed)
+// until passed to WithCircuitBreaker. The zero value is not ready to use;
+// construct one with NewCircuitBreaker.
+type CircuitBreaker struct {
+	Threshold int
+	Cooldown  time.Duration
+	metrics   CircuitBreakerMetrics
+
+	mu               sync.Mutex
+	state            circuitState
+	consecutiveFails int
+	openedAt         time.Time
+	probing          bool
+}
+
+// NewCircuitBreaker returns a CircuitBreaker that opens after threshold
+// consecutive failures and stays open for cooldown before probing again.
+func NewCircuitBreaker(threshold int, cooldown time.Duration, metrics CircuitBreakerMetrics) *CircuitBreaker {
+	if metrics.Opened == nil {
+		metrics.Opened = noopCounter{}
+	}
+	if metrics.Tripped == nil {
+		metrics.Tripped = noopCounter{}
+	}
+	return &CircuitBreaker{Threshold: threshold, Cooldown: cooldown, metrics: metrics}
+}
+
+// State reports the breaker's current state, collapsing the internal
+// half-open probe window into "open" so callers see only the two states
+// they can act on.
+func (b *CircuitBreaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.transitionIfCooldownElapsedLocked()
+	if b.state == circuitClosed {
+		return "closed"
+	}
+	return "open"
+}
+
+// transitionIfCooldownElapsedLocked moves an open breaker to half-open once
+// Cooldown has elapsed since it opened. Callers must hold b.mu.
+func (b *CircuitBreaker) transitionIfCooldownElapsedLocked() {
+	if b.state == circuitOpen && time.Since(b.openedAt) >= b.Cooldown {
+		b.state = circuitHalfOpen
+	}
+}
+
+// allow reports whether a call may proceed. A closed breaker admits every
+// call; an open one admits none. While half-open, it admits exactly one
+// call -- the probe -- marking it in-flight under b.mu so a second caller
+// racing in before that probe's recordResult is rejected instead of also
+// being let through.
+func (b *CircuitBreaker) allow() (proceed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.transitionIfCooldownElapsedLocked()
+	switch {
+	case b.state == circuitOpen:
+		return false
+	case b.state == circuitHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// recordResult updates the breaker's failure count and state based on the
+// outcome of a call that was allowed through. wasProbe is captured before
+// any state mutation so the one admitted half-open probe is always judged
+// as a probe, regardless of how long its call took relative to others.
+func (b *CircuitBreaker) recordResult(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	wasProbe := b.probing
+	b.probing = false
+	if err == nil {
+		b.consecutiveFails = 0
+		b.state = circuitClosed
+		return
+	}
+	b.consecutiveFails++
+	if wasProbe || b.state == circuitHalfOpen || b.consecutiveFails >= b.Threshold {
+		if b.state != circuitOpen {
+			b.metrics.Opened.Inc()
+		}
+		b.metrics.Tripped.Inc()
+		b.state = circuitOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// WithCircuitBreaker makes CreateWithEventNamespaceOptions consult breaker
+// before creating the event, short-circuiting with ErrCircuitOpen while it
+// is open. The default, with no breaker configured, is unchanged: every
+// create goes straight to the server regardless of recent failures.
+func WithCircuitBreaker(breaker *CircuitBreaker) CreateOption {
+	return func(c *createConfig) {
+		c.circuitBreaker = breaker
+	}
+}
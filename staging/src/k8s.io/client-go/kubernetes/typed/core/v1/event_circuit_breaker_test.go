@@ -0,0 +1,113 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func openedBreaker(t *testing.T, threshold int, cooldown time.Duration) *CircuitBreaker {
+	t.Helper()
+	b := NewCircuitBreaker(threshold, cooldown, CircuitBreakerMetrics{})
+	for i := 0; i < threshold; i++ {
+		if !b.allow() {
+			t.Fatalf("allow() = false while breaker should still be closed")
+		}
+		b.recordResult(errors.New("boom"))
+	}
+	if b.State() != "open" {
+		t.Fatalf("State() = %q, want open", b.State())
+	}
+	return b
+}
+
+func TestCircuitBreakerHalfOpenAdmitsOnlyOneConcurrentProbe(t *testing.T) {
+	b := openedBreaker(t, 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond) // let Cooldown elapse so State moves to half-open
+
+	const callers = 20
+	var admitted int32
+	var wg sync.WaitGroup
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			if b.allow() {
+				admitted++
+			}
+		}()
+	}
+	wg.Wait()
+
+	if admitted != 1 {
+		t.Fatalf("admitted = %d concurrent probes, want exactly 1", admitted)
+	}
+}
+
+func TestCircuitBreakerSecondProbeAdmittedAfterFirstRecorded(t *testing.T) {
+	b := openedBreaker(t, 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if !b.allow() {
+		t.Fatalf("allow() = false for the first half-open probe")
+	}
+	if b.allow() {
+		t.Fatalf("allow() = true for a second probe while the first is still in flight")
+	}
+	b.recordResult(nil)
+
+	if b.State() != "closed" {
+		t.Fatalf("State() = %q after a successful probe, want closed", b.State())
+	}
+	if !b.allow() {
+		t.Fatalf("allow() = false on a closed breaker")
+	}
+}
+
+func TestCircuitBreakerFailedProbeReopensDespiteConcurrentSuccessRace(t *testing.T) {
+	// Two probes contend for the same half-open window: only one is
+	// admitted (enforced by allow() itself), and its failure must still be
+	// judged against the half-open state it was admitted under, even if a
+	// racing recordResult(nil) call had already flipped the breaker closed
+	// in between -- otherwise a probe failure can land as a no-op against
+	// an already-closed breaker and the trip is silently lost.
+	b := openedBreaker(t, 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if !b.allow() {
+		t.Fatalf("allow() = false for the first half-open probe")
+	}
+	if b.allow() {
+		t.Fatalf("allow() = true for a second probe while the first is still in flight")
+	}
+
+	// Simulate another goroutine's unrelated success closing the breaker
+	// before this probe's own (failing) result is recorded.
+	b.mu.Lock()
+	b.state = circuitClosed
+	b.consecutiveFails = 0
+	b.mu.Unlock()
+
+	b.recordResult(errors.New("probe failed"))
+
+	if b.State() != "open" {
+		t.Fatalf("State() = %q after a failed probe, want open", b.State())
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// CoalescerMetrics counts the writes a Coalescer avoided versus the ones it
+// actually issued, so callers can measure the savings from batching.
+type CoalescerMetrics struct {
+	Coalesced CounterMetric
+	Issued    CounterMetric
+}
+
+// Coalescer batches rapid message mutations to the same event, identified by
+// name, into a single patch issued on a fixed interval, for controllers that
+// would otherwise send a separate PatchWithEventNamespace round-trip for
+// every small update within a tick. Mutations applied between flushes
+// overwrite each other; only the last one per name before a flush is sent.
+type Coalescer struct {
+	e        EventInterface
+	interval time.Duration
+	metrics  CoalescerMetrics
+
+	mu      sync.Mutex
+	pending map[string]*v1.Event
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewCoalescer starts a Coalescer that flushes pending mutations to e every
+// interval. Call Stop to flush any remaining mutations and end the
+// background flush loop.
+func NewCoalescer(e EventInterface, interval time.Duration, metrics CoalescerMetrics) *Coalescer {
+	if metrics.Coalesced == nil {
+		metrics.Coalesced = noopCounter{}
+	}
+	if metrics.Issued == nil {
+		metrics.Issued = noopCounter{}
+	}
+	c := &Coalescer{
+		e:        e,
+		interval: interval,
+		metrics:  metrics,
+		pending:  map[string]*v1.Event{},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go c.run()
+	return c
+}
+
+// Mutate queues event as the latest pending state for its name, to be
+// patched on the next flush. If a mutation for the same name is already
+// pending, it is replaced and counted as coalesced.
+func (c *Coalescer) Mutate(event *v1.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, pending := c.pending[event.Name]; pending {
+		c.metrics.Coalesced.Inc()
+	}
+	c.pending[event.Name] = event
+}
+
+// Stop flushes any remaining pending mutations and ends the background
+// flush loop. It blocks until the final flush completes.
+func (c *Coalescer) Stop() {
+	close(c.stop)
+	<-c.done
+}
+
+func (c *Coalescer) run() {
+	defer close(c.done)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.flush()
+		case <-c.stop:
+			c.flush()
+			return
+		}
+	}
+}
+
+func (c *Coalescer) flush() {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = map[string]*v1.Event{}
+	c.mu.Unlock()
+
+	for _, event := range pending {
+		patch, err := json.Marshal(map[string]interface{}{"message": event.Message})
+		if err != nil {
+			continue
+		}
+		if _, err := c.e.PatchWithEventNamespace(event, patch); err != nil {
+			utilruntime.HandleError(fmt.Errorf("coalescer: failed to patch event %s/%s: %w", event.Namespace, event.Name, err))
+			continue
+		}
+		c.metrics.Issued.Inc()
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type countingMetric struct {
+	count int32
+}
+
+func (c *countingMetric) Inc() { atomic.AddInt32(&c.count, 1) }
+
+func (c *countingMetric) Count() int { return int(atomic.LoadInt32(&c.count)) }
+
+func TestCoalescerFlushSkipsIssuedOnPatchFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	issued := &countingMetric{}
+	c := NewCoalescer(e, time.Hour, CoalescerMetrics{Issued: issued})
+	c.Mutate(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Message: "boom"})
+	c.Stop()
+
+	if issued.Count() != 0 {
+		t.Fatalf("Issued.Inc() called %d times on a failed patch, want 0", issued.Count())
+	}
+}
+
+func TestCoalescerFlushCountsIssuedOnSuccess(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	issued := &countingMetric{}
+	c := NewCoalescer(e, time.Hour, CoalescerMetrics{Issued: issued})
+	c.Mutate(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Message: "ok"})
+	c.Stop()
+
+	if issued.Count() != 1 {
+		t.Fatalf("Issued.Inc() called %d times, want 1", issued.Count())
+	}
+}
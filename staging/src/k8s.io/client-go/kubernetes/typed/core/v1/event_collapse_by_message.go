@@ -0,0 +1,90 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CollapsedEvent summarizes every event about an object that shares the
+// same Reason, Message and Type, the way a human reading a log would
+// naturally group repeated lines.
+type CollapsedEvent struct {
+	Reason           string
+	Message          string
+	Type             string
+	TotalOccurrences int32
+	FirstSeen        time.Time
+	LastSeen         time.Time
+}
+
+type collapsedEventKey struct {
+	reason  string
+	message string
+	type_   string
+}
+
+// SearchCollapsedByMessage searches for events about objOrRef and collapses
+// those sharing Reason, Message and Type into one CollapsedEvent apiece,
+// summing their occurrence counts (via the same Series-aware occurrences
+// logic used elsewhere in this package) and tracking the earliest and
+// latest timestamps seen across the group. Timestamps are derived with
+// EventAge, so a group's FirstSeen/LastSeen agree with how other helpers in
+// this package judge event recency.
+func SearchCollapsedByMessage(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) ([]CollapsedEvent, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	order := make([]collapsedEventKey, 0)
+	collapsed := make(map[collapsedEventKey]*CollapsedEvent)
+	for i := range list.Items {
+		event := &list.Items[i]
+		key := collapsedEventKey{reason: event.Reason, message: event.Message, type_: event.Type}
+		seenAt := now.Add(-EventAge(event, now))
+
+		entry, ok := collapsed[key]
+		if !ok {
+			entry = &CollapsedEvent{
+				Reason:    event.Reason,
+				Message:   event.Message,
+				Type:      event.Type,
+				FirstSeen: seenAt,
+				LastSeen:  seenAt,
+			}
+			collapsed[key] = entry
+			order = append(order, key)
+		}
+		entry.TotalOccurrences += occurrences(event)
+		if seenAt.Before(entry.FirstSeen) {
+			entry.FirstSeen = seenAt
+		}
+		if seenAt.After(entry.LastSeen) {
+			entry.LastSeen = seenAt
+		}
+	}
+
+	result := make([]CollapsedEvent, 0, len(order))
+	for _, key := range order {
+		result = append(result, *collapsed[key])
+	}
+	return result, nil
+}
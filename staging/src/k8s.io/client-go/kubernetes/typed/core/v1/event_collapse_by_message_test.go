@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchCollapsedByMessageGroupsByReasonMessageType(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"BackOff","message":"restarting","type":"Warning","lastTimestamp":"2024-01-01T00:00:00Z"},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"BackOff","message":"restarting","type":"Warning","lastTimestamp":"2024-01-01T00:05:00Z"},
+			{"metadata":{"name":"e3","namespace":"ns"},"reason":"Pulled","message":"pulled image","type":"Normal","lastTimestamp":"2024-01-01T00:01:00Z"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	collapsed, err := SearchCollapsedByMessage(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchCollapsedByMessage: %v", err)
+	}
+	if len(collapsed) != 2 {
+		t.Fatalf("len(collapsed) = %d, want 2: %+v", len(collapsed), collapsed)
+	}
+	if collapsed[0].TotalOccurrences != 2 {
+		t.Errorf("collapsed[0].TotalOccurrences = %d, want 2", collapsed[0].TotalOccurrences)
+	}
+	if !collapsed[0].FirstSeen.Before(collapsed[0].LastSeen) {
+		t.Errorf("FirstSeen %v should be before LastSeen %v", collapsed[0].FirstSeen, collapsed[0].LastSeen)
+	}
+	if collapsed[1].TotalOccurrences != 1 {
+		t.Errorf("collapsed[1].TotalOccurrences = %d, want 1", collapsed[1].TotalOccurrences)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// CompressedMessageAnnotation marks an event whose Message was gzipped and
+// base64-encoded by WithCompressedMessage, so DecodeMessage knows to
+// transparently decompress it.
+const CompressedMessageAnnotation = "events.k8s.io/message-compressed"
+
+// WithCompressedMessage gzips event.Message and base64-encodes the result
+// into the message field itself, stamping CompressedMessageAnnotation so
+// DecodeMessage (or any reader that checks the annotation) can reverse it.
+// This is aimed at controllers attaching large structured diagnostics to an
+// event's message that would otherwise risk the apiserver's message size
+// limit; it is opt-in and niche, not a default.
+func WithCompressedMessage() CreateOption {
+	return func(c *createConfig) {
+		c.compressMessage = true
+	}
+}
+
+// compressMessage gzips and base64-encodes message, the encoding
+// DecodeMessage expects.
+func compressMessage(message string) (string, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(message)); err != nil {
+		return "", err
+	}
+	if err := gz.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeMessage returns event's message, transparently gzip-decompressing
+// it first if it carries CompressedMessageAnnotation. Events without the
+// annotation are returned unchanged.
+func DecodeMessage(event *v1.Event) (string, error) {
+	if event.Annotations[CompressedMessageAnnotation] != "true" {
+		return event.Message, nil
+	}
+	compressed, err := base64.StdEncoding.DecodeString(event.Message)
+	if err != nil {
+		return "", fmt.Errorf("decoding compressed message: %w", err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", fmt.Errorf("decompressing message: %w", err)
+	}
+	defer gz.Close()
+	decoded, err := io.ReadAll(gz)
+	if err != nil {
+		return "", fmt.Errorf("decompressing message: %w", err)
+	}
+	return string(decoded), nil
+}
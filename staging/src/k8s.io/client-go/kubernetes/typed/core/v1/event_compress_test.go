@@ -0,0 +1,60 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCompressMessageRoundTrips(t *testing.T) {
+	original := strings.Repeat("diagnostic payload line\n", 100)
+
+	compressed, err := compressMessage(original)
+	if err != nil {
+		t.Fatalf("compressMessage: %v", err)
+	}
+	if compressed == original {
+		t.Fatal("compressMessage returned the input unchanged")
+	}
+
+	event := &v1.Event{
+		Message:    compressed,
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{CompressedMessageAnnotation: "true"}},
+	}
+	decoded, err := DecodeMessage(event)
+	if err != nil {
+		t.Fatalf("DecodeMessage: %v", err)
+	}
+	if decoded != original {
+		t.Fatalf("DecodeMessage = %q, want %q", decoded, original)
+	}
+}
+
+func TestDecodeMessagePassesThroughUncompressed(t *testing.T) {
+	event := &v1.Event{Message: "plain message"}
+	decoded, err := DecodeMessage(event)
+	if err != nil {
+		t.Fatalf("DecodeMessage: %v", err)
+	}
+	if decoded != "plain message" {
+		t.Fatalf("DecodeMessage = %q, want unchanged message", decoded)
+	}
+}
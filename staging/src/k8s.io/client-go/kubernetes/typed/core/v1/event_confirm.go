@@ -0,0 +1,68 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrConfirmTimeout is returned by CreateAndConfirm when timeout elapses
+// before the created event becomes readable by name.
+var ErrConfirmTimeout = fmt.Errorf("timed out confirming event was persisted")
+
+// confirmPollInterval is how often CreateAndConfirm re-reads the event while
+// waiting for it to become visible.
+const confirmPollInterval = 100 * time.Millisecond
+
+// CreateAndConfirm creates event and then polls for it by name until it's
+// readable or timeout elapses, guarding against the rare case where a
+// create returns success but a follow-up read doesn't yet see the object
+// (e.g. behind a lagging cache). It returns ErrConfirmTimeout, not the
+// underlying NotFound, if confirmation times out, so callers can
+// distinguish "never got created" from "created but not yet visible".
+func CreateAndConfirm(ctx context.Context, e EventInterface, event *v1.Event, timeout time.Duration) (*v1.Event, error) {
+	created, err := e.CreateWithEventNamespace(event)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(confirmPollInterval)
+	defer ticker.Stop()
+	for {
+		confirmed, err := e.Get(ctx, created.Name, metav1.GetOptions{})
+		if err == nil {
+			return confirmed, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		select {
+		case <-deadline:
+			return nil, ErrConfirmTimeout
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
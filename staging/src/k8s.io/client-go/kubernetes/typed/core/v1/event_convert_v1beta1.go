@@ -0,0 +1,106 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	eventsv1beta1 "k8s.io/api/events/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConvertFromCoreEvent converts a core/v1 Event into its events.k8s.io/v1beta1
+// counterpart, following the same field mapping apiserver storage conversion
+// uses: InvolvedObject becomes Regarding, Message becomes Note, Source
+// becomes DeprecatedSource, and FirstTimestamp/LastTimestamp/Count are
+// preserved verbatim in the matching Deprecated* fields rather than being
+// folded into Series. Series, EventTime, ReportingController,
+// ReportingInstance, Action and Related have the same name and meaning in
+// both types and are copied across unchanged. events.k8s.io/v1beta1 requires
+// EventTime; an in with neither EventTime nor FirstTimestamp set can't
+// satisfy that, so this returns an error instead of producing an Event the
+// apiserver would reject.
+func ConvertFromCoreEvent(in *v1.Event) (*eventsv1beta1.Event, error) {
+	if in.EventTime.IsZero() && in.FirstTimestamp.IsZero() {
+		return nil, fmt.Errorf("event %s/%s has neither eventTime nor firstTimestamp set", in.Namespace, in.Name)
+	}
+	out := &eventsv1beta1.Event{
+		ObjectMeta:               *in.ObjectMeta.DeepCopy(),
+		EventTime:                in.EventTime,
+		ReportingController:      in.ReportingController,
+		ReportingInstance:        in.ReportingInstance,
+		Action:                   in.Action,
+		Reason:                   in.Reason,
+		Regarding:                in.InvolvedObject,
+		Note:                     in.Message,
+		Type:                     in.Type,
+		DeprecatedSource:         in.Source,
+		DeprecatedFirstTimestamp: in.FirstTimestamp,
+		DeprecatedLastTimestamp:  in.LastTimestamp,
+		DeprecatedCount:          in.Count,
+	}
+	if out.EventTime.IsZero() {
+		out.EventTime = metav1.NewMicroTime(in.FirstTimestamp.Time)
+	}
+	if in.Related != nil {
+		related := *in.Related
+		out.Related = &related
+	}
+	if in.Series != nil {
+		out.Series = &eventsv1beta1.EventSeries{
+			Count:            in.Series.Count,
+			LastObservedTime: in.Series.LastObservedTime,
+		}
+	}
+	return out, nil
+}
+
+// ConvertToCoreEvent converts an events.k8s.io/v1beta1 Event into its core/v1
+// counterpart, the inverse of ConvertFromCoreEvent: Regarding becomes
+// InvolvedObject, Note becomes Message, and the Deprecated* fields are copied
+// back to their core/v1 names unchanged rather than being derived from
+// EventTime/Series, so a round trip through both functions preserves
+// whichever of the two timestamp representations the original event used.
+func ConvertToCoreEvent(in *eventsv1beta1.Event) (*v1.Event, error) {
+	out := &v1.Event{
+		ObjectMeta:          *in.ObjectMeta.DeepCopy(),
+		InvolvedObject:      in.Regarding,
+		Reason:              in.Reason,
+		Message:             in.Note,
+		Source:              in.DeprecatedSource,
+		FirstTimestamp:      in.DeprecatedFirstTimestamp,
+		LastTimestamp:       in.DeprecatedLastTimestamp,
+		Count:               in.DeprecatedCount,
+		Type:                in.Type,
+		EventTime:           in.EventTime,
+		Action:              in.Action,
+		ReportingController: in.ReportingController,
+		ReportingInstance:   in.ReportingInstance,
+	}
+	if in.Related != nil {
+		related := *in.Related
+		out.Related = &related
+	}
+	if in.Series != nil {
+		out.Series = &v1.EventSeries{
+			Count:            in.Series.Count,
+			LastObservedTime: in.Series.LastObservedTime,
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertFromCoreEventRejectsMissingTimestamps(t *testing.T) {
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := ConvertFromCoreEvent(event); err == nil {
+		t.Fatal("ConvertFromCoreEvent: want error for event with no eventTime or firstTimestamp")
+	}
+}
+
+func TestConvertFromCoreEventMapsFields(t *testing.T) {
+	now := metav1.Now()
+	event := &v1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject:      v1.ObjectReference{Kind: "Pod", Name: "p1", Namespace: "ns"},
+		Reason:              "Killing",
+		Message:             "stopped container",
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               3,
+		Type:                v1.EventTypeWarning,
+		Action:              "Kill",
+		ReportingController: "kubelet",
+		ReportingInstance:   "kubelet-1",
+		Series:              &v1.EventSeries{Count: 3, LastObservedTime: metav1.NewMicroTime(now.Time)},
+	}
+
+	out, err := ConvertFromCoreEvent(event)
+	if err != nil {
+		t.Fatalf("ConvertFromCoreEvent: %v", err)
+	}
+	if out.Regarding != event.InvolvedObject {
+		t.Fatalf("Regarding = %+v, want %+v", out.Regarding, event.InvolvedObject)
+	}
+	if out.Note != event.Message {
+		t.Fatalf("Note = %q, want %q", out.Note, event.Message)
+	}
+	if out.Reason != event.Reason || out.Type != event.Type || out.Action != event.Action {
+		t.Fatalf("Reason/Type/Action = %q/%q/%q, want %q/%q/%q", out.Reason, out.Type, out.Action, event.Reason, event.Type, event.Action)
+	}
+	if out.DeprecatedFirstTimestamp != event.FirstTimestamp || out.DeprecatedLastTimestamp != event.LastTimestamp || out.DeprecatedCount != event.Count {
+		t.Fatalf("deprecated timestamps/count not preserved: %+v", out)
+	}
+	if out.Series == nil || out.Series.Count != event.Series.Count {
+		t.Fatalf("Series = %+v, want Count %d", out.Series, event.Series.Count)
+	}
+}
+
+func TestConvertRoundTripPreservesCoreFields(t *testing.T) {
+	now := metav1.Now()
+	original := &v1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject:      v1.ObjectReference{Kind: "Pod", Name: "p1", Namespace: "ns"},
+		Reason:              "Killing",
+		Message:             "stopped container",
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               3,
+		Type:                v1.EventTypeWarning,
+		ReportingController: "kubelet",
+		ReportingInstance:   "kubelet-1",
+	}
+
+	converted, err := ConvertFromCoreEvent(original)
+	if err != nil {
+		t.Fatalf("ConvertFromCoreEvent: %v", err)
+	}
+	roundTripped, err := ConvertToCoreEvent(converted)
+	if err != nil {
+		t.Fatalf("ConvertToCoreEvent: %v", err)
+	}
+	if roundTripped.InvolvedObject != original.InvolvedObject ||
+		roundTripped.Message != original.Message ||
+		roundTripped.Reason != original.Reason ||
+		roundTripped.Type != original.Type ||
+		roundTripped.FirstTimestamp != original.FirstTimestamp ||
+		roundTripped.LastTimestamp != original.LastTimestamp ||
+		roundTripped.Count != original.Count ||
+		roundTripped.ReportingController != original.ReportingController ||
+		roundTripped.ReportingInstance != original.ReportingInstance {
+		t.Fatalf("round trip mismatch: got %+v, want fields matching %+v", roundTripped, original)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// countForObjectPageSize is the page size CountForObject requests while
+// paging through all of an object's events, matching eventExportPageSize's
+// reasoning: bound memory to one page at a time rather than reading
+// everything at once.
+const countForObjectPageSize = 500
+
+// CountForObject returns the total number of occurrences of every event
+// about objOrRef, paging through all of them via SearchEach. An aggregated
+// event (non-nil Series) counts as Series.Count+1 occurrences -- the
+// original occurrence plus every repeat the series recorded -- while a
+// single, unaggregated event counts as 1.
+func CountForObject(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (int, error) {
+	total := 0
+	err := SearchEach(ctx, e, scheme, objOrRef, countForObjectPageSize, func(event *v1.Event) error {
+		if event.Series != nil {
+			total += int(event.Series.Count) + 1
+		} else {
+			total++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
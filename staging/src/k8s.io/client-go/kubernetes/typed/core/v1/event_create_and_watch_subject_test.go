@@ -0,0 +1,66 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCreateAndWatchSubjectCreatesThenWatchesFromResourceVersion(t *testing.T) {
+	var gotResourceVersion string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			var e v1.Event
+			json.NewDecoder(r.Body).Decode(&e)
+			e.ResourceVersion = "42"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(e)
+			return
+		}
+		gotResourceVersion = r.URL.Query().Get("resourceVersion")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	created, w, err := CreateAndWatchSubject(context.Background(), e, scheme, pod, event)
+	if err != nil {
+		t.Fatalf("CreateAndWatchSubject: %v", err)
+	}
+	defer w.Stop()
+
+	if created.ResourceVersion != "42" {
+		t.Errorf("created.ResourceVersion = %q, want %q", created.ResourceVersion, "42")
+	}
+	if gotResourceVersion != "42" {
+		t.Errorf("watch resourceVersion = %q, want %q", gotResourceVersion, "42")
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateWithEventNamespaceDefaulted is CreateWithEventNamespaceContext,
+// except that before sending the request it fills in event.EventTime with
+// metav1.NowMicro() if it's zero, and event.Type with v1.EventTypeNormal if
+// it's empty -- the two fields NewEvent already stamps for a caller that
+// builds one through it, but that a caller assembling an event by hand is
+// prone to leaving unset. It never mutates the event the caller passed in;
+// CreateWithEventNamespace and CreateWithEventNamespaceContext are
+// unaffected and still send exactly what's given them.
+func CreateWithEventNamespaceDefaulted(ctx context.Context, e EventInterface, event *v1.Event) (*v1.Event, error) {
+	if !event.EventTime.IsZero() && event.Type != "" {
+		return e.CreateWithEventNamespaceContext(ctx, event)
+	}
+	defaulted := *event
+	if defaulted.EventTime.IsZero() {
+		defaulted.EventTime = metav1.NowMicro()
+	}
+	if defaulted.Type == "" {
+		defaulted.Type = v1.EventTypeNormal
+	}
+	return e.CreateWithEventNamespaceContext(ctx, &defaulted)
+}
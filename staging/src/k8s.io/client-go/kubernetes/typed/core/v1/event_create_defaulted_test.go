@@ -0,0 +1,89 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateWithEventNamespaceDefaultedFillsUnsetFields(t *testing.T) {
+	var got v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := CreateWithEventNamespaceDefaulted(context.Background(), e, event); err != nil {
+		t.Fatalf("CreateWithEventNamespaceDefaulted: %v", err)
+	}
+	if got.EventTime.IsZero() {
+		t.Fatalf("expected EventTime to be defaulted")
+	}
+	if got.Type != v1.EventTypeNormal {
+		t.Fatalf("Type = %q, want %q", got.Type, v1.EventTypeNormal)
+	}
+	if !event.EventTime.IsZero() || event.Type != "" {
+		t.Fatalf("expected the caller's own event to be left untouched, got %+v", event)
+	}
+}
+
+func TestCreateWithEventNamespaceDefaultedPreservesSetFields(t *testing.T) {
+	var got v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	explicitTime := metav1.NewMicroTime(time.Unix(100, 0))
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		EventTime:  explicitTime,
+		Type:       v1.EventTypeWarning,
+	}
+
+	if _, err := CreateWithEventNamespaceDefaulted(context.Background(), e, event); err != nil {
+		t.Fatalf("CreateWithEventNamespaceDefaulted: %v", err)
+	}
+	if !got.EventTime.Equal(&explicitTime) {
+		t.Fatalf("EventTime = %v, want unchanged %v", got.EventTime, explicitTime)
+	}
+	if got.Type != v1.EventTypeWarning {
+		t.Fatalf("Type = %q, want unchanged %q", got.Type, v1.EventTypeWarning)
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// CreateOrUpdateWithEventNamespace tries to update event first, since most
+// callers emitting a recurring event already know it exists; if the server
+// reports it doesn't (NotFound), this falls back to creating it. If a
+// concurrent creator won the race (AlreadyExists), this retries the update
+// once, on the theory that the event now exists and can be updated. Reusing
+// CreateWithEventNamespace/UpdateWithEventNamespace means the usual
+// namespace guard still applies, and any other error (including a genuine
+// update Conflict) is returned to the caller unchanged.
+func CreateOrUpdateWithEventNamespace(e EventInterface, event *v1.Event) (*v1.Event, error) {
+	result, err := e.UpdateWithEventNamespace(event)
+	if err == nil {
+		return result, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	result, err = e.CreateWithEventNamespace(event)
+	if err == nil {
+		return result, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+	return e.UpdateWithEventNamespace(event)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateOrUpdateWithEventNamespaceUpdatesExisting(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	result, err := CreateOrUpdateWithEventNamespace(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateWithEventNamespace: %v", err)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+	if len(calls) != 1 || calls[0] != http.MethodPut {
+		t.Fatalf("calls = %v, want a single PUT", calls)
+	}
+}
+
+func TestCreateOrUpdateWithEventNamespaceFallsBackToCreateOnNotFound(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	result, err := CreateOrUpdateWithEventNamespace(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateWithEventNamespace: %v", err)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+	if len(calls) != 2 || calls[0] != http.MethodPut || calls[1] != http.MethodPost {
+		t.Fatalf("calls = %v, want PUT then POST", calls)
+	}
+}
+
+func TestCreateOrUpdateWithEventNamespaceRetriesUpdateOnAlreadyExists(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && len(calls) == 1:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"AlreadyExists","code":409}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	result, err := CreateOrUpdateWithEventNamespace(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateWithEventNamespace: %v", err)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+	if len(calls) != 3 || calls[0] != http.MethodPut || calls[1] != http.MethodPost || calls[2] != http.MethodPut {
+		t.Fatalf("calls = %v, want PUT, POST, PUT", calls)
+	}
+}
+
+func TestCreateOrUpdateWithEventNamespaceSurfacesConflict(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Conflict","code":409}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	if _, err := CreateOrUpdateWithEventNamespace(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}); err == nil {
+		t.Fatal("expected the update Conflict to be returned to the caller")
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateInClientNamespace creates event, ignoring event.Namespace entirely
+// and always posting to the client's configured namespace. This is the
+// least-surprising behavior for a controller scoped to a single namespace,
+// where the implicit namespace-deduction magic in CreateWithEventNamespace
+// (which lets event.Namespace win) is more flexibility than wanted. It
+// errors if e was built with the "" namespace, since there would be no
+// client namespace to fall back to. CreateWithEventNamespace remains the
+// right choice for the broadcaster's cross-namespace needs.
+func CreateInClientNamespace(ctx context.Context, e EventInterface, event *v1.Event) (*v1.Event, error) {
+	if impl, ok := e.(*events); ok && impl.ns == "" {
+		return nil, fmt.Errorf("can't create an event in the client namespace: client was built with the \"\" namespace")
+	}
+	return e.Create(ctx, event, metav1.CreateOptions{})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateInClientNamespaceRejectsUnscopedClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for an unscoped client")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	if _, err := CreateInClientNamespace(context.Background(), e, event); err == nil {
+		t.Fatalf("expected an error for a client built with the \"\" namespace")
+	}
+}
+
+func TestCreateInClientNamespacePostsToClientNamespace(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	if _, err := CreateInClientNamespace(context.Background(), e, event); err != nil {
+		t.Fatalf("CreateInClientNamespace: %v", err)
+	}
+	if want := "/api/v1/namespaces/ns/events"; gotPath != want {
+		t.Errorf("path = %q, want %q", gotPath, want)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// cursorPayload is the data SearchWithCursor encodes into its opaque cursor
+// string. Unlike the apiserver's Continue token, it survives across calls
+// indefinitely: it encodes enough of the query (the field selector) and
+// position (the last-seen event's namespace/name) to resume a scan without
+// depending on a server-side watch cache entry that may have expired.
+type cursorPayload struct {
+	FieldSelector string `json:"fieldSelector"`
+	LastNamespace string `json:"lastNamespace"`
+	LastName      string `json:"lastName"`
+}
+
+// SearchWithCursor returns one page of up to pageSize events about objOrRef,
+// ordered by namespace/name for a stable scan order, along with an opaque
+// cursor to pass as the cursor argument on the next call to continue where
+// this one left off. An empty cursor starts the scan from the beginning.
+//
+// Because the cursor only records a position, not a snapshot, events
+// deleted after a cursor was issued are simply skipped over on the next
+// call, and events created after a cursor was issued but sorting before its
+// position are missed, same as any position-based (not snapshot-based) scan.
+// The returned cursor is "" once the scan is exhausted.
+func SearchWithCursor(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, cursor string, pageSize int) (*v1.EventList, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("pageSize must be positive, got %d", pageSize)
+	}
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, "", err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	// involvedObjectFieldSelector builds its string from a map, whose
+	// iteration order (and therefore the term order in the resulting
+	// selector string) isn't stable across calls even for the identical
+	// objRef. Canonicalize before using it as the cursor's "same search"
+	// identity, or two calls for the same search could take different term
+	// orders and spuriously fail the comparison below.
+	canonicalSelector := canonicalizeFieldSelector(fieldSelector)
+
+	var last cursorPayload
+	if cursor != "" {
+		last, err = decodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if last.FieldSelector != canonicalSelector {
+			return nil, "", fmt.Errorf("cursor was issued for a different search")
+		}
+	}
+
+	all, err := e.List(context.TODO(), metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Slice(all.Items, func(i, j int) bool {
+		if all.Items[i].Namespace != all.Items[j].Namespace {
+			return all.Items[i].Namespace < all.Items[j].Namespace
+		}
+		return all.Items[i].Name < all.Items[j].Name
+	})
+
+	start := 0
+	if cursor != "" {
+		start = sort.Search(len(all.Items), func(i int) bool {
+			item := all.Items[i]
+			if item.Namespace != last.LastNamespace {
+				return item.Namespace > last.LastNamespace
+			}
+			return item.Name > last.LastName
+		})
+	}
+
+	page := &v1.EventList{}
+	end := start + pageSize
+	if end > len(all.Items) {
+		end = len(all.Items)
+	}
+	page.Items = all.Items[start:end]
+
+	if end == len(all.Items) {
+		return page, "", nil
+	}
+	next := all.Items[end-1]
+	nextCursor, err := encodeCursor(cursorPayload{
+		FieldSelector: canonicalSelector,
+		LastNamespace: next.Namespace,
+		LastName:      next.Name,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return page, nextCursor, nil
+}
+
+// canonicalizeFieldSelector sorts a comma-separated field selector's terms,
+// so two selector strings built from the same terms in a different order
+// compare equal.
+func canonicalizeFieldSelector(selector string) string {
+	if selector == "" {
+		return selector
+	}
+	terms := strings.Split(selector, ",")
+	sort.Strings(terms)
+	return strings.Join(terms, ",")
+}
+
+func encodeCursor(p cursorPayload) (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(cursor string) (cursorPayload, error) {
+	data, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return cursorPayload{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	var p cursorPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return cursorPayload{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return p, nil
+}
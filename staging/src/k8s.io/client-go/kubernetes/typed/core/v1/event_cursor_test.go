@@ -0,0 +1,83 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func cursorTestArgs(t *testing.T, body string) (*httptest.Server, EventInterface, *runtime.Scheme, *v1.Pod) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	return srv, e, scheme, pod
+}
+
+func TestSearchWithCursorRejectsNonPositivePageSize(t *testing.T) {
+	srv, e, scheme, pod := cursorTestArgs(t, `{"items":[]}`)
+	defer srv.Close()
+
+	for _, pageSize := range []int{0, -1} {
+		if _, _, err := SearchWithCursor(e, scheme, pod, "", pageSize); err == nil {
+			t.Fatalf("pageSize=%d: expected an error, got nil", pageSize)
+		}
+	}
+}
+
+func TestSearchWithCursorPaginatesAndExhausts(t *testing.T) {
+	srv, e, scheme, pod := cursorTestArgs(t, `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"}},
+		{"metadata":{"name":"e2","namespace":"ns"}},
+		{"metadata":{"name":"e3","namespace":"ns"}}
+	]}`)
+	defer srv.Close()
+
+	page, cursor, err := SearchWithCursor(e, scheme, pod, "", 2)
+	if err != nil {
+		t.Fatalf("SearchWithCursor: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Name != "e1" || page.Items[1].Name != "e2" {
+		t.Fatalf("first page = %v, want [e1 e2]", page.Items)
+	}
+	if cursor == "" {
+		t.Fatalf("expected a non-empty cursor with more items remaining")
+	}
+
+	page, cursor, err = SearchWithCursor(e, scheme, pod, cursor, 2)
+	if err != nil {
+		t.Fatalf("SearchWithCursor (page 2): %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "e3" {
+		t.Fatalf("second page = %v, want [e3]", page.Items)
+	}
+	if cursor != "" {
+		t.Fatalf("expected an empty cursor once the scan is exhausted, got %q", cursor)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// DefaultingEventClient wraps an EventInterface to apply a fixed
+// reportingController/reportingInstance to every create whose event doesn't
+// already set them, so a controller that always reports as the same source
+// doesn't have to repeat it at every call site (and can't forget it, which
+// would otherwise silently break source-based filtering). A per-event value
+// always wins over the default. All other EventInterface methods pass
+// through unchanged.
+type DefaultingEventClient struct {
+	EventInterface
+	DefaultReportingController string
+	DefaultReportingInstance   string
+}
+
+// NewDefaultingEventClient returns an EventInterface that behaves like e
+// except that CreateWithEventNamespace fills in reportingController and
+// reportingInstance from the given defaults when an event leaves them
+// empty. Either default can be left "" to not apply one.
+func NewDefaultingEventClient(e EventInterface, reportingController, reportingInstance string) *DefaultingEventClient {
+	return &DefaultingEventClient{
+		EventInterface:             e,
+		DefaultReportingController: reportingController,
+		DefaultReportingInstance:   reportingInstance,
+	}
+}
+
+// CreateWithEventNamespace defaults event's reportingController and
+// reportingInstance before delegating to the wrapped EventInterface.
+func (d *DefaultingEventClient) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	defaulted := event
+	if d.DefaultReportingController != "" && defaulted.ReportingController == "" {
+		withController := *defaulted
+		withController.ReportingController = d.DefaultReportingController
+		defaulted = &withController
+	}
+	if d.DefaultReportingInstance != "" && defaulted.ReportingInstance == "" {
+		withInstance := *defaulted
+		withInstance.ReportingInstance = d.DefaultReportingInstance
+		defaulted = &withInstance
+	}
+	return d.EventInterface.CreateWithEventNamespace(defaulted)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDefaultingEventClientFillsEmptyReportingFields(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	d := NewDefaultingEventClient(e, "my-controller", "my-instance")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := d.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if sent.ReportingController != "my-controller" {
+		t.Errorf("ReportingController = %q, want my-controller", sent.ReportingController)
+	}
+	if sent.ReportingInstance != "my-instance" {
+		t.Errorf("ReportingInstance = %q, want my-instance", sent.ReportingInstance)
+	}
+}
+
+func TestDefaultingEventClientDoesNotOverrideExplicitValues(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	d := NewDefaultingEventClient(e, "my-controller", "my-instance")
+	event := &v1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		ReportingController: "explicit-controller",
+	}
+
+	if _, err := d.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if sent.ReportingController != "explicit-controller" {
+		t.Errorf("ReportingController = %q, want explicit-controller", sent.ReportingController)
+	}
+	if sent.ReportingInstance != "my-instance" {
+		t.Errorf("ReportingInstance = %q, want my-instance", sent.ReportingInstance)
+	}
+	if event.ReportingInstance != "" {
+		t.Errorf("caller's event was mutated: ReportingInstance = %q, want empty", event.ReportingInstance)
+	}
+}
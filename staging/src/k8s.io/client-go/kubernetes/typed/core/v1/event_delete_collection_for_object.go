@@ -0,0 +1,42 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// DeleteCollectionForObject deletes every event about objOrRef, using the
+// same involved-object field selector Search builds and the same namespace
+// guard, so callers cleaning up an object's event history don't have to
+// build the selector themselves.
+func DeleteCollectionForObject(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, opts metav1.DeleteOptions) error {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return err
+	}
+	if impl, ok := e.(*events); ok && impl.ns != "" && objRef.Namespace != impl.ns {
+		return fmt.Errorf("won't be able to find any events of namespace '%v' in namespace '%v'", objRef.Namespace, impl.ns)
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	return e.DeleteCollection(ctx, opts, metav1.ListOptions{FieldSelector: fieldSelector})
+}
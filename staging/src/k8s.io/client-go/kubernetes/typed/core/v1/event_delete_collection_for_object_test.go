@@ -0,0 +1,69 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDeleteCollectionForObjectForwardsSelectorAndDryRun(t *testing.T) {
+	var gotFieldSelector string
+	var gotMethod string
+	var gotBody metav1.DeleteOptions
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	err := DeleteCollectionForObject(context.Background(), e, scheme, pod, metav1.DeleteOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		t.Fatalf("DeleteCollectionForObject: %v", err)
+	}
+
+	if gotMethod != http.MethodDelete {
+		t.Fatalf("method = %q, want DELETE", gotMethod)
+	}
+	want := sortedFieldSelectorTerms(involvedObjectFieldSelector(e, &v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"}))
+	if sortedFieldSelectorTerms(gotFieldSelector) != want {
+		t.Fatalf("fieldSelector = %q, want terms %q", gotFieldSelector, want)
+	}
+	if len(gotBody.DryRun) != 1 || gotBody.DryRun[0] != metav1.DryRunAll {
+		t.Fatalf("DryRun = %v, want [%q]", gotBody.DryRun, metav1.DryRunAll)
+	}
+}
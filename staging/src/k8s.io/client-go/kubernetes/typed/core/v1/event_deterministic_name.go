@@ -0,0 +1,63 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GenerateDeterministicName derives a stable event name from event's
+// identity -- namespace, involved object UID, reason, action and reporting
+// controller -- by hashing them with crypto/sha256, so multiple replicas of
+// the same controller emitting the same logical event produce the same
+// name instead of separate objects. Unlike DeterministicEventName's
+// "<name>.<reason>" shape, this hashes with a fixed algorithm rather than
+// relying on string concatenation or map iteration order, so it stays
+// stable across process restarts and Go versions.
+func GenerateDeterministicName(event *v1.Event) string {
+	h := sha256.New()
+	for _, part := range []string{
+		event.Namespace,
+		string(event.InvolvedObject.UID),
+		event.Reason,
+		event.Action,
+		event.ReportingController,
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return "evt-" + hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// NewDeterministicEvent is NewEvent, except the returned event's Name is
+// set via GenerateDeterministicName instead of being left for the
+// apiserver to fill in from GenerateName, so repeated calls describing the
+// same logical event converge on one object name; CreateOrUpdateWithEventNamespace
+// against that name then naturally dedups across replicas.
+func NewDeterministicEvent(scheme *runtime.Scheme, objOrRef runtime.Object, eventType, reason, action, message, reportingController, reportingInstance string) (*v1.Event, error) {
+	event, err := NewEvent(scheme, objOrRef, eventType, reason, action, message, reportingController, reportingInstance)
+	if err != nil {
+		return nil, err
+	}
+	event.GenerateName = ""
+	event.Name = GenerateDeterministicName(event)
+	return event, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestGenerateDeterministicNameIsStableAcrossCalls(t *testing.T) {
+	a := &v1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Namespace: "ns"},
+		InvolvedObject:      v1.ObjectReference{UID: types.UID("u1")},
+		Reason:              "Killing",
+		Action:              "Kill",
+		ReportingController: "kubelet",
+	}
+	b := &v1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Namespace: "ns"},
+		InvolvedObject:      v1.ObjectReference{UID: types.UID("u1")},
+		Reason:              "Killing",
+		Action:              "Kill",
+		ReportingController: "kubelet",
+	}
+
+	nameA := GenerateDeterministicName(a)
+	nameB := GenerateDeterministicName(b)
+	if nameA != nameB {
+		t.Fatalf("expected identical events to produce the same name, got %q and %q", nameA, nameB)
+	}
+	if nameA != GenerateDeterministicName(a) {
+		t.Fatalf("expected repeated calls on the same event to be stable")
+	}
+}
+
+func TestGenerateDeterministicNameDiffersOnIdentityChange(t *testing.T) {
+	base := &v1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Namespace: "ns"},
+		InvolvedObject:      v1.ObjectReference{UID: types.UID("u1")},
+		Reason:              "Killing",
+		Action:              "Kill",
+		ReportingController: "kubelet",
+	}
+	changedReason := base.DeepCopy()
+	changedReason.Reason = "Started"
+
+	if GenerateDeterministicName(base) == GenerateDeterministicName(changedReason) {
+		t.Fatalf("expected a different reason to produce a different name")
+	}
+}
+
+func TestNewDeterministicEventSetsNameInsteadOfGenerateName(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	event, err := NewDeterministicEvent(scheme, pod, v1.EventTypeNormal, "Started", "Start", "started", "kubelet", "node1")
+	if err != nil {
+		t.Fatalf("NewDeterministicEvent: %v", err)
+	}
+	if event.GenerateName != "" {
+		t.Fatalf("expected GenerateName to be cleared, got %q", event.GenerateName)
+	}
+	if event.Name != GenerateDeterministicName(event) {
+		t.Fatalf("Name = %q, want %q", event.Name, GenerateDeterministicName(event))
+	}
+
+	other, err := NewDeterministicEvent(scheme, pod, v1.EventTypeNormal, "Started", "Start", "started", "kubelet", "node1")
+	if err != nil {
+		t.Fatalf("NewDeterministicEvent: %v", err)
+	}
+	if other.Name != event.Name {
+		t.Fatalf("expected two calls describing the same logical event to produce the same name, got %q and %q", other.Name, event.Name)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// FieldChange records the before/after value of one changed field, as
+// rendered strings suitable for a human-readable audit record.
+type FieldChange struct {
+	Before string
+	After  string
+}
+
+// DiffEvents compares old and new and returns the set of fields that
+// changed between them, keyed by field name, for audit logging of event
+// updates. It only looks at fields that legitimately change across an
+// update (message, count, type, series timestamps); it ignores
+// resourceVersion and managedFields, which change on every write and would
+// otherwise swamp every diff.
+func DiffEvents(old, new *v1.Event) map[string]FieldChange {
+	changes := map[string]FieldChange{}
+	if old.Message != new.Message {
+		changes["message"] = FieldChange{Before: old.Message, After: new.Message}
+	}
+	if old.Count != new.Count {
+		changes["count"] = FieldChange{Before: strconv.Itoa(int(old.Count)), After: strconv.Itoa(int(new.Count))}
+	}
+	if old.Type != new.Type {
+		changes["type"] = FieldChange{Before: old.Type, After: new.Type}
+	}
+	oldSeries, newSeries := "", ""
+	if old.Series != nil {
+		oldSeries = old.Series.LastObservedTime.String()
+	}
+	if new.Series != nil {
+		newSeries = new.Series.LastObservedTime.String()
+	}
+	if oldSeries != newSeries {
+		changes["series.lastObservedTime"] = FieldChange{Before: oldSeries, After: newSeries}
+	}
+	return changes
+}
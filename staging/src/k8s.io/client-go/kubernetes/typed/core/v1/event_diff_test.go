@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiffEventsCountBumpAndMessageChange(t *testing.T) {
+	old := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+		Message:    "pulling image",
+		Count:      1,
+		Type:       v1.EventTypeNormal,
+	}
+	new := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"},
+		Message:    "pulled image",
+		Count:      2,
+		Type:       v1.EventTypeNormal,
+	}
+
+	changes := DiffEvents(old, new)
+
+	if _, ok := changes["resourceVersion"]; ok {
+		t.Error("resourceVersion should be ignored by DiffEvents")
+	}
+	msgChange, ok := changes["message"]
+	if !ok {
+		t.Fatal("expected a message change")
+	}
+	if msgChange.Before != "pulling image" || msgChange.After != "pulled image" {
+		t.Errorf("unexpected message change: %+v", msgChange)
+	}
+	countChange, ok := changes["count"]
+	if !ok {
+		t.Fatal("expected a count change")
+	}
+	if countChange.Before != "1" || countChange.After != "2" {
+		t.Errorf("unexpected count change: %+v", countChange)
+	}
+	if _, ok := changes["type"]; ok {
+		t.Error("type did not change and should not appear in the diff")
+	}
+}
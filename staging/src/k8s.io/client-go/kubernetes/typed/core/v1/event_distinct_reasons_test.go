@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDistinctReasonsForObjectPagesAndSortsReasons(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if requests == 1 {
+			w.Write([]byte(`{"metadata":{"continue":"page2"},"items":[{"metadata":{"name":"e1","namespace":"ns"},"reason":"Pulled"}]}`))
+			return
+		}
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e2","namespace":"ns"},"reason":"BackOff"}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	reasons, err := DistinctReasonsForObject(context.Background(), e, scheme, pod)
+	if err != nil {
+		t.Fatalf("DistinctReasonsForObject: %v", err)
+	}
+	if len(reasons) != 2 || reasons[0] != "BackOff" || reasons[1] != "Pulled" {
+		t.Fatalf("reasons = %v, want [BackOff Pulled]", reasons)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// CreateWithEventNamespaceDryRun behaves like CreateWithEventNamespace, but
+// passes metav1.DryRunAll so the apiserver validates and returns the
+// would-be result without persisting anything. Like the other
+// direct-REST-access helpers in this package (e.g.
+// CreateWithEventNamespaceTimeout), it only applies when e is backed
+// by the generated *events client; for other EventInterface implementations
+// it falls back to an ordinary create, since there's no raw request to
+// attach query parameters to.
+func CreateWithEventNamespaceDryRun(e EventInterface, event *v1.Event) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.CreateWithEventNamespace(event)
+	}
+	if impl.ns != "" && event.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't create an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns))
+	}
+	result := &v1.Event{}
+	err := impl.client.Post().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource("events").
+		VersionedParams(&metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}, scheme.ParameterCodec).
+		Body(event).
+		Do(context.TODO()).
+		Into(result)
+	return result, err
+}
+
+// UpdateWithEventNamespaceDryRun is UpdateWithEventNamespace with
+// metav1.DryRunAll; see CreateWithEventNamespaceDryRun for the fallback
+// behavior when e isn't backed by the generated client.
+func UpdateWithEventNamespaceDryRun(e EventInterface, event *v1.Event) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.UpdateWithEventNamespace(event)
+	}
+	if impl.ns != "" && event.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't update an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns))
+	}
+	result := &v1.Event{}
+	err := impl.client.Put().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource("events").
+		Name(event.Name).
+		VersionedParams(&metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}}, scheme.ParameterCodec).
+		Body(event).
+		Do(context.TODO()).
+		Into(result)
+	return result, err
+}
+
+// PatchWithEventNamespaceDryRun is PatchWithEventNamespaceOfType with
+// metav1.DryRunAll; see CreateWithEventNamespaceDryRun for the fallback
+// behavior when e isn't backed by the generated client.
+func PatchWithEventNamespaceDryRun(ctx context.Context, e EventInterface, event *v1.Event, pt types.PatchType, data []byte) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.PatchWithEventNamespaceOfType(ctx, event, pt, data)
+	}
+	if impl.ns != "" && event.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't patch an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns))
+	}
+	result := &v1.Event{}
+	err := impl.client.Patch(pt).
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource("events").
+		Name(event.Name).
+		VersionedParams(&metav1.PatchOptions{DryRun: []string{metav1.DryRunAll}}, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
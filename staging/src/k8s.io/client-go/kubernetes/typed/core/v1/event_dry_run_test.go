@@ -0,0 +1,99 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCreateWithEventNamespaceDryRunSerializesDryRunParam(t *testing.T) {
+	var gotDryRun []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotDryRun = r.URL.Query()["dryRun"]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	if _, err := CreateWithEventNamespaceDryRun(e, &v1.Event{}); err != nil {
+		t.Fatalf("CreateWithEventNamespaceDryRun: %v", err)
+	}
+	if len(gotDryRun) != 1 || gotDryRun[0] != metav1.DryRunAll {
+		t.Fatalf("dryRun = %v, want [%q]", gotDryRun, metav1.DryRunAll)
+	}
+}
+
+func TestCreateWithEventNamespaceDryRunEnforcesNamespaceGuard(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when the namespace guard rejects the event")
+	}))
+	defer srv.Close()
+
+	base := newTestEventsClient(t, srv)
+	scoped := &events{client: base.client, ns: "configured"}
+	if _, err := CreateWithEventNamespaceDryRun(scoped, &v1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "other"}}); err == nil {
+		t.Fatal("expected a namespace-mismatch error")
+	}
+}
+
+func TestUpdateWithEventNamespaceDryRunSerializesDryRunParam(t *testing.T) {
+	var gotDryRun []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotDryRun = r.URL.Query()["dryRun"]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	if _, err := UpdateWithEventNamespaceDryRun(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}); err != nil {
+		t.Fatalf("UpdateWithEventNamespaceDryRun: %v", err)
+	}
+	if len(gotDryRun) != 1 || gotDryRun[0] != metav1.DryRunAll {
+		t.Fatalf("dryRun = %v, want [%q]", gotDryRun, metav1.DryRunAll)
+	}
+}
+
+func TestPatchWithEventNamespaceDryRunSerializesDryRunParam(t *testing.T) {
+	var gotDryRun []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotDryRun = r.URL.Query()["dryRun"]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	data := []byte(`{"count":5}`)
+	if _, err := PatchWithEventNamespaceDryRun(context.Background(), e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}, types.StrategicMergePatchType, data); err != nil {
+		t.Fatalf("PatchWithEventNamespaceDryRun: %v", err)
+	}
+	if len(gotDryRun) != 1 || gotDryRun[0] != metav1.DryRunAll {
+		t.Fatalf("dryRun = %v, want [%q]", gotDryRun, metav1.DryRunAll)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FindDuplicatesForObject searches for events about objOrRef and groups
+// together those that are SameLogicalEvent, returning only the groups that
+// contain more than one event. Buggy controllers sometimes create many
+// separate events that should have aggregated into one series; this is the
+// detection half, pairing with MergeDuplicates to collapse a group.
+func FindDuplicatesForObject(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) ([][]*v1.Event, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	var groups [][]*v1.Event
+	for i := range list.Items {
+		event := &list.Items[i]
+		placed := false
+		for g := range groups {
+			if SameLogicalEvent(groups[g][0], event) {
+				groups[g] = append(groups[g], event)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			groups = append(groups, []*v1.Event{event})
+		}
+	}
+	var duplicates [][]*v1.Event
+	for _, g := range groups {
+		if len(g) > 1 {
+			duplicates = append(duplicates, g)
+		}
+	}
+	return duplicates, nil
+}
+
+// MergeDuplicates collapses a group of duplicate events (as found by
+// FindDuplicatesForObject) into one: it keeps the event with the latest
+// LastTimestamp, patches its count to the sum of the group's occurrence
+// counts, and deletes the rest. It returns the surviving, updated event.
+func MergeDuplicates(ctx context.Context, e EventInterface, group []*v1.Event) (*v1.Event, error) {
+	survivor := group[0]
+	var total int32
+	for _, event := range group {
+		total += occurrences(event)
+		if event.LastTimestamp.After(survivor.LastTimestamp.Time) {
+			survivor = event
+		}
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"count": total})
+	if err != nil {
+		return nil, err
+	}
+	merged, err := e.Patch(ctx, survivor.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range group {
+		if event.Name == survivor.Name {
+			continue
+		}
+		if err := e.Delete(ctx, event.Name, metav1.DeleteOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFindDuplicatesForObjectGroupsSameLogicalEvents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"Pulled","message":"m","lastTimestamp":"2024-01-01T00:00:00Z"},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"Pulled","message":"m","lastTimestamp":"2024-01-01T00:05:00Z"},
+			{"metadata":{"name":"e3","namespace":"ns"},"reason":"Scheduled","message":"n"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	duplicates, err := FindDuplicatesForObject(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("FindDuplicatesForObject: %v", err)
+	}
+	if len(duplicates) != 1 || len(duplicates[0]) != 2 {
+		t.Fatalf("duplicates = %v, want one group of 2", duplicates)
+	}
+}
+
+func TestMergeDuplicatesKeepsLatestAndDeletesRest(t *testing.T) {
+	var deleted []string
+	var patchedCount string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPatch:
+			buf := make([]byte, r.ContentLength)
+			r.Body.Read(buf)
+			patchedCount = string(buf)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"metadata":{"name":"e2","namespace":"ns"},"count":3}`))
+		case http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/ns/events/"))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	early := metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	later := metav1.Time{Time: time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)}
+	group := []*v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, LastTimestamp: early, Count: 1},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e2", Namespace: "ns"}, LastTimestamp: later, Count: 2},
+	}
+
+	merged, err := MergeDuplicates(context.Background(), e, group)
+	if err != nil {
+		t.Fatalf("MergeDuplicates: %v", err)
+	}
+	if merged.Name != "e2" {
+		t.Errorf("merged.Name = %q, want e2 (the latest)", merged.Name)
+	}
+	if !strings.Contains(patchedCount, "3") {
+		t.Errorf("patch body = %q, want it to total count to 3", patchedCount)
+	}
+	if len(deleted) != 1 || deleted[0] != "e1" {
+		t.Errorf("deleted = %v, want [e1]", deleted)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchExcludingController searches for events about objOrRef and returns
+// only those whose ReportingController isn't excludeController, for a "what
+// did other controllers say" view that isolates externally-reported
+// problems from a controller's own chatter about the object. Field
+// selectors can't express negation, so this is necessarily a client-side
+// filter applied after an ordinary Search.
+func SearchExcludingController(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, excludeController string) (*v1.EventList, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	filtered := list.DeepCopy()
+	filtered.Items = filtered.Items[:0]
+	for i := range list.Items {
+		if list.Items[i].ReportingController != excludeController {
+			filtered.Items = append(filtered.Items, list.Items[i])
+		}
+	}
+	return filtered, nil
+}
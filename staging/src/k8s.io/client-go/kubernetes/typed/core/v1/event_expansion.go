@@ -19,24 +19,36 @@ package v1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	ref "k8s.io/client-go/tools/reference"
 )
 
 // The EventExpansion interface allows manually adding extra methods to the EventInterface.
 type EventExpansion interface {
 	// CreateWithEventNamespace is the same as a Create, except that it sends the request to the event.Namespace.
 	CreateWithEventNamespace(event *v1.Event) (*v1.Event, error)
+	// CreateWithEventNamespaceContext is CreateWithEventNamespace with a caller-supplied context, so a
+	// cancelled or timed-out ctx aborts the request instead of running it to completion.
+	CreateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (*v1.Event, error)
 	// UpdateWithEventNamespace is the same as a Update, except that it sends the request to the event.Namespace.
 	UpdateWithEventNamespace(event *v1.Event) (*v1.Event, error)
+	// UpdateWithEventNamespaceContext is UpdateWithEventNamespace with a caller-supplied context.
+	UpdateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (*v1.Event, error)
 	PatchWithEventNamespace(event *v1.Event, data []byte) (*v1.Event, error)
+	// PatchWithEventNamespaceContext is PatchWithEventNamespace with a caller-supplied context.
+	PatchWithEventNamespaceContext(ctx context.Context, event *v1.Event, data []byte) (*v1.Event, error)
+	// PatchWithEventNamespaceOfType is PatchWithEventNamespace with an explicit patch type, for
+	// events.k8s.io fields (e.g. series) that a strategic merge patch can't express.
+	PatchWithEventNamespaceOfType(ctx context.Context, event *v1.Event, pt types.PatchType, data []byte) (*v1.Event, error)
 	// Search finds events about the specified object
 	Search(scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error)
+	// SearchContext is Search with a caller-supplied context.
+	SearchContext(ctx context.Context, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error)
 	// Returns the appropriate field selector based on the API version being used to communicate with the server.
 	// The returned field selector can be used with List and Watch to filter desired events.
 	GetFieldSelector(involvedObjectName, involvedObjectNamespace, involvedObjectKind, involvedObjectUID *string) fields.Selector
@@ -47,15 +59,23 @@ type EventExpansion interface {
 // event; it must either match this event client's namespace, or this event
 // client must have been created with the "" namespace.
 func (e *events) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	return e.CreateWithEventNamespaceContext(context.TODO(), event)
+}
+
+// CreateWithEventNamespaceContext is CreateWithEventNamespace with a
+// caller-supplied context; a cancelled ctx aborts the POST mid-flight and
+// the call returns ctx.Err() (wrapped by the underlying rest.Request).
+func (e *events) CreateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (result *v1.Event, err error) {
+	defer func(start time.Time) { observeWrite("create", start, err) }(time.Now())
 	if e.ns != "" && event.Namespace != e.ns {
-		return nil, fmt.Errorf("can't create an event with namespace '%v' in namespace '%v'", event.Namespace, e.ns)
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't create an event with namespace '%v' in namespace '%v'", event.Namespace, e.ns))
 	}
-	result := &v1.Event{}
-	err := e.client.Post().
+	result = &v1.Event{}
+	err = e.client.Post().
 		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
-		Resource("events").
+		Resource(eventResourceName()).
 		Body(event).
-		Do(context.TODO()).
+		Do(ctx).
 		Into(result)
 	return result, err
 }
@@ -66,13 +86,23 @@ func (e *events) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
 // created with the "" namespace. Update also requires the ResourceVersion to be set in the event
 // object.
 func (e *events) UpdateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
-	result := &v1.Event{}
-	err := e.client.Put().
+	return e.UpdateWithEventNamespaceContext(context.TODO(), event)
+}
+
+// UpdateWithEventNamespaceContext is UpdateWithEventNamespace with a
+// caller-supplied context.
+func (e *events) UpdateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (result *v1.Event, err error) {
+	defer func(start time.Time) { observeWrite("update", start, err) }(time.Now())
+	if e.ns != "" && event.Namespace != e.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't update an event with namespace '%v' in namespace '%v'", event.Namespace, e.ns))
+	}
+	result = &v1.Event{}
+	err = e.client.Put().
 		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
-		Resource("events").
+		Resource(eventResourceName()).
 		Name(event.Name).
 		Body(event).
-		Do(context.TODO()).
+		Do(ctx).
 		Into(result)
 	return result, err
 }
@@ -83,67 +113,72 @@ func (e *events) UpdateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
 // match this event client's namespace, or this event client must have been
 // created with the "" namespace.
 func (e *events) PatchWithEventNamespace(incompleteEvent *v1.Event, data []byte) (*v1.Event, error) {
-	if e.ns != "" && incompleteEvent.Namespace != e.ns {
-		return nil, fmt.Errorf("can't patch an event with namespace '%v' in namespace '%v'", incompleteEvent.Namespace, e.ns)
-	}
-	result := &v1.Event{}
-	err := e.client.Patch(types.StrategicMergePatchType).
-		NamespaceIfScoped(incompleteEvent.Namespace, len(incompleteEvent.Namespace) > 0).
-		Resource("events").
-		Name(incompleteEvent.Name).
-		Body(data).
-		Do(context.TODO()).
-		Into(result)
-	return result, err
+	return e.PatchWithEventNamespaceContext(context.TODO(), incompleteEvent, data)
+}
+
+// PatchWithEventNamespaceContext is PatchWithEventNamespace with a
+// caller-supplied context.
+func (e *events) PatchWithEventNamespaceContext(ctx context.Context, incompleteEvent *v1.Event, data []byte) (*v1.Event, error) {
+	return e.PatchWithEventNamespaceOfType(ctx, incompleteEvent, types.StrategicMergePatchType, data)
+}
+
+// PatchWithEventNamespaceOfType is PatchWithEventNamespace with an explicit
+// pt, for callers that need types.MergePatchType or types.JSONPatchType
+// instead of the strategic merge patch PatchWithEventNamespace defaults to
+// (events.k8s.io doesn't register a strategic merge strategy for every
+// field, e.g. series). It delegates to PatchWithEventNamespaceByName, which
+// only needs incompleteEvent's Namespace and Name.
+func (e *events) PatchWithEventNamespaceOfType(ctx context.Context, incompleteEvent *v1.Event, pt types.PatchType, data []byte) (*v1.Event, error) {
+	return PatchWithEventNamespaceByName(ctx, e, incompleteEvent.Namespace, incompleteEvent.Name, pt, data)
 }
 
 // Search finds events about the specified object. The namespace of the
 // object must match this event's client namespace unless the event client
 // was made with the "" namespace.
 func (e *events) Search(scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
-	ref, err := ref.GetReference(scheme, objOrRef)
-	if err != nil {
-		return nil, err
-	}
-	if len(e.ns) > 0 && ref.Namespace != e.ns {
-		return nil, fmt.Errorf("won't be able to find any events of namespace '%v' in namespace '%v'", ref.Namespace, e.ns)
-	}
-	stringRefKind := string(ref.Kind)
-	var refKind *string
-	if len(stringRefKind) > 0 {
-		refKind = &stringRefKind
-	}
-	stringRefUID := string(ref.UID)
-	var refUID *string
-	if len(stringRefUID) > 0 {
-		refUID = &stringRefUID
-	}
-	fieldSelector := e.GetFieldSelector(&ref.Name, &ref.Namespace, refKind, refUID)
-	return e.List(context.TODO(), metav1.ListOptions{FieldSelector: fieldSelector.String()})
+	return e.SearchContext(context.TODO(), scheme, objOrRef)
+}
+
+// SearchContext is Search with a caller-supplied context.
+func (e *events) SearchContext(ctx context.Context, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	return SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{})
 }
 
 // Returns the appropriate field selector based on the API version being used to communicate with the server.
 // The returned field selector can be used with List and Watch to filter desired events.
+//
+// A pointer to an empty string is treated the same as a nil pointer for
+// every argument: it's omitted from the selector rather than added as a
+// literal field=="" term, so callers that build these pointers from
+// optional, possibly-empty fields (e.g. objRef.Namespace on a
+// cluster-scoped object) don't need to nil them out themselves first.
 func (e *events) GetFieldSelector(involvedObjectName, involvedObjectNamespace, involvedObjectKind, involvedObjectUID *string) fields.Selector {
 	field := fields.Set{}
-	if involvedObjectName != nil {
+	if involvedObjectName != nil && *involvedObjectName != "" {
 		field["involvedObject.name"] = *involvedObjectName
 	}
-	if involvedObjectNamespace != nil {
+	if involvedObjectNamespace != nil && *involvedObjectNamespace != "" {
 		field["involvedObject.namespace"] = *involvedObjectNamespace
 	}
-	if involvedObjectKind != nil {
+	if involvedObjectKind != nil && *involvedObjectKind != "" {
 		field["involvedObject.kind"] = *involvedObjectKind
 	}
-	if involvedObjectUID != nil {
+	if involvedObjectUID != nil && *involvedObjectUID != "" {
 		field["involvedObject.uid"] = *involvedObjectUID
 	}
 	return field.AsSelector()
 }
 
-// Returns the appropriate field label to use for name of the involved object as per the given API version.
-// DEPRECATED: please use "involvedObject.name" inline.
+// Returns the appropriate field label to use for name of the involved object
+// as per the given API version. Core/v1 (and anything outside the
+// events.k8s.io group) indexes "involvedObject.name"; events.k8s.io/v1 and
+// events.k8s.io/v1beta1 index the same object reference under
+// "regarding.name" instead. See GetFieldSelectorForVersion for the rest of
+// the involved/regarding object fields.
 func GetInvolvedObjectNameFieldLabel(version string) string {
+	if isEventsAPIGroupVersion(version) {
+		return "regarding.name"
+	}
 	return "involvedObject.name"
 }
 
@@ -0,0 +1,161 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// newTestEventsClient returns an *events backed by a RESTClient pointed at
+// srv, the same way newEvents wires one up from a CoreV1Client, so tests in
+// this package can exercise the hand-written EventExpansion methods against
+// real HTTP requests instead of a fake clientset.
+func newTestEventsClient(t *testing.T, srv *httptest.Server) *events {
+	t.Helper()
+	base, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("parsing test server URL: %v", err)
+	}
+	gv := v1.SchemeGroupVersion
+	client, err := rest.NewRESTClient(base, "/api/v1", rest.ClientContentConfig{
+		GroupVersion: gv,
+		Negotiator:   runtime.NewClientNegotiator(scheme.Codecs.WithoutConversion(), gv),
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("building test REST client: %v", err)
+	}
+	return &events{client: client, ns: ""}
+}
+
+func TestCreateWithEventNamespaceContextAbortsBeforeResponse(t *testing.T) {
+	received := make(chan struct{})
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-block
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	defer close(block)
+
+	e := newTestEventsClient(t, srv)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+
+	_, err := e.CreateWithEventNamespaceContext(ctx, &v1.Event{})
+	if err == nil {
+		t.Fatal("expected cancellation to produce an error")
+	}
+}
+
+func TestCreateWithEventNamespaceContextSucceedsAfterResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := e.CreateWithEventNamespaceContext(ctx, &v1.Event{})
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceContext: %v", err)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+}
+
+func TestCreateWithEventNamespaceDelegatesToContextVariant(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	result, err := e.CreateWithEventNamespace(&v1.Event{})
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+}
+
+func TestPatchWithEventNamespaceOfTypeSendsJSONPatchContentType(t *testing.T) {
+	var gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"},"count":5}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	data := []byte(`[{"op":"replace","path":"/count","value":5}]`)
+	result, err := e.PatchWithEventNamespaceOfType(context.Background(), &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}, types.JSONPatchType, data)
+	if err != nil {
+		t.Fatalf("PatchWithEventNamespaceOfType: %v", err)
+	}
+	if gotContentType != string(types.JSONPatchType) {
+		t.Fatalf("Content-Type = %q, want %q", gotContentType, types.JSONPatchType)
+	}
+	if result.Count != 5 {
+		t.Fatalf("Count = %d, want 5", result.Count)
+	}
+}
+
+func TestPatchWithEventNamespaceDefaultsToStrategicMergePatch(t *testing.T) {
+	var gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	_, err := e.PatchWithEventNamespace(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}, []byte(`{"count":5}`))
+	if err != nil {
+		t.Fatalf("PatchWithEventNamespace: %v", err)
+	}
+	if gotContentType != string(types.StrategicMergePatchType) {
+		t.Fatalf("Content-Type = %q, want %q", gotContentType, types.StrategicMergePatchType)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// eventExportPageSize bounds the memory used by ExportNamespace: only one
+// page of events is held in memory at a time.
+const eventExportPageSize = 500
+
+// ExportNamespace pages through every event in the client's namespace and
+// writes them to w as newline-delimited JSON, one event per line, with
+// bounded memory. Use ExportNamespaceResume to continue a dump that was
+// interrupted partway through.
+func ExportNamespace(ctx context.Context, e EventInterface, w io.Writer) error {
+	return ExportNamespaceResume(ctx, e, w, "")
+}
+
+// ExportNamespaceResume continues a namespace export started by
+// ExportNamespace (or a previous ExportNamespaceResume) from continueToken,
+// which the caller should have checkpointed from the last successful page.
+// Passing an empty continueToken starts from the beginning.
+func ExportNamespaceResume(ctx context.Context, e EventInterface, w io.Writer, continueToken string) error {
+	enc := json.NewEncoder(w)
+	for {
+		list, err := e.List(ctx, metav1.ListOptions{Limit: eventExportPageSize, Continue: continueToken})
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			if err := enc.Encode(&list.Items[i]); err != nil {
+				return err
+			}
+		}
+		continueToken = list.Continue
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
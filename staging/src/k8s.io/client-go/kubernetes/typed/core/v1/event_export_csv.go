@@ -0,0 +1,83 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// ExportCSV searches for events about objOrRef and streams them to w as CSV
+// with columns timestamp, type, reason, reportingController, count,
+// message, writing rows page by page so a long history doesn't buffer in
+// memory. encoding/csv handles escaping commas, quotes and newlines in the
+// message column.
+func ExportCSV(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, w io.Writer) error {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{"timestamp", "type", "reason", "reportingController", "count", "message"}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var continueToken string
+	for {
+		page, err := e.List(ctx, metav1.ListOptions{
+			FieldSelector: fieldSelector,
+			Limit:         eventExportPageSize,
+			Continue:      continueToken,
+		})
+		if err != nil {
+			return err
+		}
+		for i := range page.Items {
+			event := &page.Items[i]
+			timestamp := now.Add(-EventAge(event, now)).Format(time.RFC3339)
+			row := []string{
+				timestamp,
+				event.Type,
+				event.Reason,
+				event.ReportingController,
+				strconv.Itoa(int(occurrences(event))),
+				event.Message,
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return err
+		}
+		continueToken = page.Continue
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
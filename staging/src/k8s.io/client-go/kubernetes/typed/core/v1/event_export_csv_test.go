@@ -0,0 +1,78 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExportCSVWritesHeaderAndRows(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"type":"Warning","reason":"BackOff","reportingComponent":"kubelet","count":2,"message":"has a, comma"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	var buf bytes.Buffer
+	if err := ExportCSV(context.Background(), e, scheme, pod, &buf); err != nil {
+		t.Fatalf("ExportCSV: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("parsing CSV output: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("len(records) = %d, want 2 (header + 1 row): %v", len(records), records)
+	}
+	if want := []string{"timestamp", "type", "reason", "reportingController", "count", "message"}; !equalStrings(records[0], want) {
+		t.Errorf("header = %v, want %v", records[0], want)
+	}
+	row := records[1]
+	if row[1] != "Warning" || row[2] != "BackOff" || row[3] != "kubelet" || row[4] != "2" || row[5] != "has a, comma" {
+		t.Errorf("row = %v, unexpected values", row)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExportNamespacePagesThroughAllEvents(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if requests == 1 {
+			w.Write([]byte(`{"metadata":{"continue":"page2"},"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e2","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	var buf bytes.Buffer
+	if err := ExportNamespace(context.Background(), e, &buf); err != nil {
+		t.Fatalf("ExportNamespace: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("exported %d lines, want 2: %q", len(lines), buf.String())
+	}
+}
+
+func TestExportNamespaceResumeStartsFromContinueToken(t *testing.T) {
+	var gotContinue string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContinue = r.URL.Query().Get("continue")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e2","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	var buf bytes.Buffer
+	if err := ExportNamespaceResume(context.Background(), e, &buf, "resume-token"); err != nil {
+		t.Fatalf("ExportNamespaceResume: %v", err)
+	}
+	if gotContinue != "resume-token" {
+		t.Errorf("continue = %q, want resume-token", gotContinue)
+	}
+}
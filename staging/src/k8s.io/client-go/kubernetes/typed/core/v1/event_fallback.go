@@ -0,0 +1,83 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// ClientSideFallbackMetric counts Searches that fell back to client-side
+// filtering via SearchWithClientSideFallback. It defaults to a no-op and can
+// be replaced by a caller that wants to alert on a field selector the
+// apiserver stopped (or never did) support.
+var ClientSideFallbackMetric CounterMetric = noopCounter{}
+
+// SearchWithClientSideFallback searches for events about objOrRef the normal
+// way, and if the apiserver rejects the field selector as unsupported (an
+// apiserver that doesn't index involvedObject fields, e.g. some aggregated
+// API servers), retries by listing every event in scope and filtering for
+// the involved object's name/namespace/kind/UID locally. The fallback is
+// opt-in because it is strictly more expensive (it lists every event,
+// instead of letting the server do the filtering) and ClientSideFallbackMetric
+// is incremented each time it is used so the cost is visible.
+func SearchWithClientSideFallback(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err == nil || !apierrors.IsBadRequest(err) && !apierrors.IsNotAcceptable(err) {
+		return list, err
+	}
+
+	objRef, refErr := ref.GetReference(scheme, objOrRef)
+	if refErr != nil {
+		return nil, err
+	}
+	ClientSideFallbackMetric.Inc()
+
+	all, listErr := e.List(context.TODO(), metav1.ListOptions{})
+	if listErr != nil {
+		return nil, listErr
+	}
+	filtered := all.DeepCopy()
+	filtered.Items = nil
+	for i := range all.Items {
+		if involvedObjectMatches(&all.Items[i].InvolvedObject, objRef) {
+			filtered.Items = append(filtered.Items, all.Items[i])
+		}
+	}
+	return filtered, nil
+}
+
+// involvedObjectMatches reports whether candidate refers to the same object
+// as objRef, using the same fields (name, namespace, kind, UID, when set)
+// that GetFieldSelector would have sent to the server.
+func involvedObjectMatches(candidate, objRef *v1.ObjectReference) bool {
+	if candidate.Name != objRef.Name || candidate.Namespace != objRef.Namespace {
+		return false
+	}
+	if objRef.Kind != "" && candidate.Kind != objRef.Kind {
+		return false
+	}
+	if objRef.UID != "" && candidate.UID != objRef.UID {
+		return false
+	}
+	return true
+}
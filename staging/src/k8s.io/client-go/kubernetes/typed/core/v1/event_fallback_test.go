@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchWithClientSideFallbackUsesServerResultWhenSupported(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	list, err := SearchWithClientSideFallback(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchWithClientSideFallback: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("len(list.Items) = %d, want 1", len(list.Items))
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1 (no fallback expected)", requests)
+	}
+}
+
+func TestSearchWithClientSideFallbackFiltersLocallyOnBadRequest(t *testing.T) {
+	metric := &countingMetric{}
+	old := ClientSideFallbackMetric
+	ClientSideFallbackMetric = metric
+	defer func() { ClientSideFallbackMetric = old }()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"kind":"Status","status":"Failure","reason":"BadRequest"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"involvedObject":{"name":"p1","namespace":"ns","kind":"Pod","uid":"u1"}},
+			{"metadata":{"name":"e2","namespace":"ns"},"involvedObject":{"name":"other","namespace":"ns","kind":"Pod","uid":"u2"}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	list, err := SearchWithClientSideFallback(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchWithClientSideFallback: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Fatalf("list.Items = %v, want [e1]", list.Items)
+	}
+	if metric.Count() != 1 {
+		t.Errorf("ClientSideFallbackMetric count = %d, want 1", metric.Count())
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "testing"
+
+func TestGetFieldSelectorTreatsEmptyStringPointerAsNil(t *testing.T) {
+	e := &events{}
+	empty := ""
+	set := "x"
+
+	tests := []struct {
+		name string
+		call func() string
+	}{
+		{"name", func() string { return e.GetFieldSelector(&empty, nil, nil, nil).String() }},
+		{"namespace", func() string { return e.GetFieldSelector(nil, &empty, nil, nil).String() }},
+		{"kind", func() string { return e.GetFieldSelector(nil, nil, &empty, nil).String() }},
+		{"uid", func() string { return e.GetFieldSelector(nil, nil, nil, &empty).String() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.call(); got != "" {
+				t.Errorf("GetFieldSelector with only %s set to a pointer-to-empty-string = %q, want empty selector", tt.name, got)
+			}
+		})
+	}
+
+	// A non-empty value for the same field should still come through, to
+	// guard against the empty check swallowing real values too.
+	if got := e.GetFieldSelector(&set, nil, nil, nil).String(); got != "involvedObject.name=x" {
+		t.Errorf("GetFieldSelector(name=%q) = %q, want involvedObject.name=x", set, got)
+	}
+}
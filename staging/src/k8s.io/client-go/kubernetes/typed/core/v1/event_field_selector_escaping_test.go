@@ -0,0 +1,57 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// TestGetFieldSelectorEscapesSpecialCharacters guards against a regression
+// to an unescaped concatenation of field selector terms: GetFieldSelector
+// builds its result through fields.Set.AsSelector(), whose terms already
+// escape "," and "=" in values (fields.EscapeValue), so a value containing
+// either round-trips through ParseSelector unambiguously instead of being
+// misread as extra selector terms.
+func TestGetFieldSelectorEscapesSpecialCharacters(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "comma", value: "a,b"},
+		{name: "equals", value: "a=b"},
+		{name: "both", value: "a,b=c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &events{}
+			selector := e.GetFieldSelector(&tt.value, nil, nil, nil)
+			parsed, err := fields.ParseSelector(selector.String())
+			if err != nil {
+				t.Fatalf("ParseSelector(%q): %v", selector.String(), err)
+			}
+			got, found := parsed.RequiresExactMatch("involvedObject.name")
+			if !found {
+				t.Fatalf("parsed selector %q doesn't constrain involvedObject.name", selector.String())
+			}
+			if got != tt.value {
+				t.Fatalf("parsed involvedObject.name = %q, want %q", got, tt.value)
+			}
+		})
+	}
+}
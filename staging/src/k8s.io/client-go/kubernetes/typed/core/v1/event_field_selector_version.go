@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// eventsAPIGroup is the API group of the events.k8s.io Event type, whose
+// indexed fields use "regarding.*" in place of core/v1's "involvedObject.*".
+const eventsAPIGroup = "events.k8s.io"
+
+func isEventsAPIGroupVersion(version string) bool {
+	return strings.HasPrefix(version, eventsAPIGroup+"/")
+}
+
+// ReportingControllerFieldLabel returns the field label used to select on an
+// event's reporting controller, as per the given API version: core/v1 indexes
+// it under ReportingController's own json name, "reportingComponent";
+// events.k8s.io/v1 and events.k8s.io/v1beta1 index the same field under
+// "reportingController" instead.
+func ReportingControllerFieldLabel(version string) string {
+	if isEventsAPIGroupVersion(version) {
+		return "reportingController"
+	}
+	return "reportingComponent"
+}
+
+// GetFieldSelectorForVersion is GetFieldSelector for a caller who knows the
+// apiVersion their Event objects actually came from. Core/v1 (and any
+// apiVersion outside the events.k8s.io group) is indexed on
+// "involvedObject.*", matching GetFieldSelector exactly; events.k8s.io/v1
+// and events.k8s.io/v1beta1 index the same object reference under
+// "regarding.*" instead, so a selector built against one won't match events
+// served by the other. A non-nil reportingController is added under the
+// label ReportingControllerFieldLabel returns for version; a nil
+// reportingController, like the other nil-able parameters, omits the term
+// entirely instead of matching on an empty value.
+func GetFieldSelectorForVersion(version string, involvedObjectName, involvedObjectNamespace, involvedObjectKind, involvedObjectUID, reportingController *string) fields.Selector {
+	prefix := "involvedObject"
+	if isEventsAPIGroupVersion(version) {
+		prefix = "regarding"
+	}
+	field := fields.Set{}
+	if involvedObjectName != nil {
+		field[prefix+".name"] = *involvedObjectName
+	}
+	if involvedObjectNamespace != nil {
+		field[prefix+".namespace"] = *involvedObjectNamespace
+	}
+	if involvedObjectKind != nil {
+		field[prefix+".kind"] = *involvedObjectKind
+	}
+	if involvedObjectUID != nil {
+		field[prefix+".uid"] = *involvedObjectUID
+	}
+	if reportingController != nil {
+		field[ReportingControllerFieldLabel(version)] = *reportingController
+	}
+	return field.AsSelector()
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "testing"
+
+func TestGetInvolvedObjectNameFieldLabel(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "v1", want: "involvedObject.name"},
+		{version: "events.k8s.io/v1", want: "regarding.name"},
+		{version: "events.k8s.io/v1beta1", want: "regarding.name"},
+	}
+	for _, tt := range tests {
+		if got := GetInvolvedObjectNameFieldLabel(tt.version); got != tt.want {
+			t.Errorf("GetInvolvedObjectNameFieldLabel(%q) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestGetFieldSelectorForVersion(t *testing.T) {
+	name, namespace, kind, uid := "p1", "ns", "Pod", "u1"
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{
+			version: "v1",
+			want:    "involvedObject.kind=Pod,involvedObject.name=p1,involvedObject.namespace=ns,involvedObject.uid=u1",
+		},
+		{
+			version: "events.k8s.io/v1",
+			want:    "regarding.kind=Pod,regarding.name=p1,regarding.namespace=ns,regarding.uid=u1",
+		},
+		{
+			version: "events.k8s.io/v1beta1",
+			want:    "regarding.kind=Pod,regarding.name=p1,regarding.namespace=ns,regarding.uid=u1",
+		},
+	}
+	for _, tt := range tests {
+		got := GetFieldSelectorForVersion(tt.version, &name, &namespace, &kind, &uid, nil).String()
+		if sortedFieldSelectorTerms(got) != sortedFieldSelectorTerms(tt.want) {
+			t.Errorf("GetFieldSelectorForVersion(%q, ...) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestGetFieldSelectorForVersionOmitsReportingControllerWhenNil(t *testing.T) {
+	if got := GetFieldSelectorForVersion("v1", nil, nil, nil, nil, nil).String(); got != "" {
+		t.Errorf("GetFieldSelectorForVersion with all-nil params = %q, want empty selector", got)
+	}
+}
+
+func TestGetFieldSelectorForVersionIncludesReportingControllerWhenSet(t *testing.T) {
+	reportingController := "kubelet"
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "v1", want: "reportingComponent=kubelet"},
+		{version: "events.k8s.io/v1", want: "reportingController=kubelet"},
+		{version: "events.k8s.io/v1beta1", want: "reportingController=kubelet"},
+	}
+	for _, tt := range tests {
+		if got := GetFieldSelectorForVersion(tt.version, nil, nil, nil, nil, &reportingController).String(); got != tt.want {
+			t.Errorf("GetFieldSelectorForVersion(%q, reportingController) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
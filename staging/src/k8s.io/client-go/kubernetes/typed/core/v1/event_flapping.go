@@ -0,0 +1,63 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DetectFlapping searches for events about objOrRef within window and
+// reports whether the object is flapping between reasonA and reasonB: it
+// counts how many times, in time order, a reasonA event is immediately
+// followed by a reasonB event or vice versa (events with any other reason
+// are ignored), and returns true if that count is at least minTransitions.
+// It also returns the raw transition count so a caller can log or alert on
+// the trend even when it hasn't crossed the threshold yet.
+func DetectFlapping(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, reasonA, reasonB string, window time.Duration, minTransitions int) (bool, int, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return false, 0, err
+	}
+
+	now := time.Now()
+	var relevant []*v1.Event
+	for i := range list.Items {
+		event := &list.Items[i]
+		if event.Reason != reasonA && event.Reason != reasonB {
+			continue
+		}
+		if EventAge(event, now) > window {
+			continue
+		}
+		relevant = append(relevant, event)
+	}
+	sort.Slice(relevant, func(i, j int) bool {
+		return EventAge(relevant[i], now) > EventAge(relevant[j], now)
+	})
+
+	transitions := 0
+	for i := 1; i < len(relevant); i++ {
+		if relevant[i].Reason != relevant[i-1].Reason {
+			transitions++
+		}
+	}
+	return transitions >= minTransitions, transitions, nil
+}
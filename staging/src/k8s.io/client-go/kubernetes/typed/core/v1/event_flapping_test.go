@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDetectFlappingCountsAlternatingTransitions(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) string { return now.Add(-d).UTC().Format(time.RFC3339) }
+	body := `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"},"reason":"Ready","lastTimestamp":"` + ts(4*time.Minute) + `"},
+		{"metadata":{"name":"e2","namespace":"ns"},"reason":"NotReady","lastTimestamp":"` + ts(3*time.Minute) + `"},
+		{"metadata":{"name":"e3","namespace":"ns"},"reason":"Unrelated","lastTimestamp":"` + ts(2*time.Minute) + `"},
+		{"metadata":{"name":"e4","namespace":"ns"},"reason":"Ready","lastTimestamp":"` + ts(time.Minute) + `"}
+	]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	flapping, transitions, err := DetectFlapping(e, scheme, pod, "Ready", "NotReady", time.Hour, 2)
+	if err != nil {
+		t.Fatalf("DetectFlapping: %v", err)
+	}
+	if transitions != 2 {
+		t.Fatalf("transitions = %d, want 2 (Ready->NotReady->Ready, ignoring the unrelated event)", transitions)
+	}
+	if !flapping {
+		t.Errorf("flapping = false, want true: transitions (2) meets minTransitions (2)")
+	}
+}
+
+func TestDetectFlappingBelowThreshold(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) string { return now.Add(-d).UTC().Format(time.RFC3339) }
+	body := `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"},"reason":"Ready","lastTimestamp":"` + ts(2*time.Minute) + `"},
+		{"metadata":{"name":"e2","namespace":"ns"},"reason":"NotReady","lastTimestamp":"` + ts(time.Minute) + `"}
+	]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	flapping, transitions, err := DetectFlapping(e, scheme, pod, "Ready", "NotReady", time.Hour, 2)
+	if err != nil {
+		t.Fatalf("DetectFlapping: %v", err)
+	}
+	if transitions != 1 {
+		t.Fatalf("transitions = %d, want 1", transitions)
+	}
+	if flapping {
+		t.Errorf("flapping = true, want false: transitions (1) is below minTransitions (2)")
+	}
+}
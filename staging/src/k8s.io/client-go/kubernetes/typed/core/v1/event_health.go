@@ -0,0 +1,58 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// HealthForObject searches for events about objOrRef within window and
+// reports the object unhealthy if any of them is a Warning event. reasons
+// lists the distinct reasons seen among the events within window, sorted
+// and de-duplicated, regardless of type, so a caller can see what's been
+// happening even when the verdict is healthy.
+func HealthForObject(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, window time.Duration) (bool, []string, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return false, nil, err
+	}
+
+	now := time.Now()
+	healthy := true
+	reasonSet := make(map[string]struct{})
+	for i := range list.Items {
+		event := &list.Items[i]
+		if EventAge(event, now) > window {
+			continue
+		}
+		reasonSet[event.Reason] = struct{}{}
+		if event.Type == v1.EventTypeWarning {
+			healthy = false
+		}
+	}
+
+	reasons := make([]string, 0, len(reasonSet))
+	for reason := range reasonSet {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	return healthy, reasons, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHealthForObjectReportsUnhealthyOnRecentWarning(t *testing.T) {
+	recent := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	stale := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	body := `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"},"type":"Normal","reason":"Scheduled","lastTimestamp":"` + recent + `"},
+		{"metadata":{"name":"e2","namespace":"ns"},"type":"Warning","reason":"BackOff","lastTimestamp":"` + recent + `"},
+		{"metadata":{"name":"e3","namespace":"ns"},"type":"Warning","reason":"FailedMount","lastTimestamp":"` + stale + `"}
+	]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	healthy, reasons, err := HealthForObject(e, scheme, pod, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("HealthForObject: %v", err)
+	}
+	if healthy {
+		t.Errorf("healthy = true, want false: a Warning event is within window")
+	}
+	want := []string{"BackOff", "Scheduled"}
+	if !reflect.DeepEqual(reasons, want) {
+		t.Errorf("reasons = %v, want %v (the stale FailedMount event should be excluded)", reasons, want)
+	}
+}
+
+func TestHealthForObjectReportsHealthyWithNoWarnings(t *testing.T) {
+	recent := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	body := `{"items":[{"metadata":{"name":"e1","namespace":"ns"},"type":"Normal","reason":"Scheduled","lastTimestamp":"` + recent + `"}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	healthy, _, err := HealthForObject(e, scheme, pod, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("HealthForObject: %v", err)
+	}
+	if !healthy {
+		t.Errorf("healthy = false, want true: no Warning events within window")
+	}
+}
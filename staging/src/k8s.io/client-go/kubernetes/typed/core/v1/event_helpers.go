@@ -0,0 +1,122 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// InvolvedObjectReference extracts a usable object reference from event's
+// involvedObject fields. It is the inverse of the ref-to-selector logic used
+// by Search: given an event, it returns a reference the caller can pass to a
+// dynamic or typed client to fetch the live object. It never returns nil;
+// when apiVersion/kind are empty it returns a partially-populated reference.
+func InvolvedObjectReference(event *v1.Event) *v1.ObjectReference {
+	involved := event.InvolvedObject
+	return &involved
+}
+
+// SameLogicalEvent reports whether a and b represent the same logical event:
+// same involved object, reason, action and type. It ignores timestamps,
+// counts and names so that a freshly-built event can be compared against one
+// already on the server to decide whether to aggregate or skip it.
+func SameLogicalEvent(a, b *v1.Event) bool {
+	return a.InvolvedObject == b.InvolvedObject &&
+		a.Reason == b.Reason &&
+		a.Action == b.Action &&
+		a.Type == b.Type &&
+		a.Message == b.Message
+}
+
+// MergeEventLists concatenates lists, dedups items by UID, and sets the
+// merged list's ResourceVersion to the highest ResourceVersion among the
+// inputs. It is the building block SearchMulti and similar multi-source
+// search helpers use, and is exported so callers can do their own custom
+// merges of results gathered from multiple searches or shards.
+func MergeEventLists(lists ...*v1.EventList) *v1.EventList {
+	merged := &v1.EventList{}
+	seen := make(map[types.UID]bool)
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		if rvLess(merged.ResourceVersion, list.ResourceVersion) {
+			merged.ResourceVersion = list.ResourceVersion
+		}
+		for _, event := range list.Items {
+			if seen[event.UID] {
+				continue
+			}
+			seen[event.UID] = true
+			merged.Items = append(merged.Items, event)
+		}
+	}
+	return merged
+}
+
+// rvLess compares two resourceVersion strings numerically where possible,
+// falling back to treating any non-numeric or empty value as the smaller one.
+func rvLess(a, b string) bool {
+	if b == "" {
+		return false
+	}
+	if a == "" {
+		return true
+	}
+	an, aerr := strconv.ParseUint(a, 10, 64)
+	bn, berr := strconv.ParseUint(b, 10, 64)
+	if aerr != nil || berr != nil {
+		return a < b
+	}
+	return an < bn
+}
+
+// EventAge computes how old event is relative to now, using the canonical
+// precedence returned by eventTimestamp. Centralizing this precedence avoids
+// the subtle bugs that show up when different callers pick a different
+// timestamp field for "how old is this event".
+func EventAge(event *v1.Event, now time.Time) time.Duration {
+	return now.Sub(eventTimestamp(event))
+}
+
+// eventTimestamp picks the single best available timestamp for event: the
+// series' LastObservedTime if the event is part of a series, else
+// LastTimestamp, else EventTime, else the object's creationTimestamp.
+// LastTimestamp is checked before EventTime because it's the field a classic
+// (non-series) event updates as its Count is bumped across repeated
+// occurrences, while EventTime is normally only set on events that use
+// Series instead; a non-series event with a populated LastTimestamp should
+// report its most recent occurrence, not its creationTimestamp. Every
+// timestamp-ordering or timestamp-bucketing operation in this package
+// (EventAge, SearchHistogram, SearchIncidents) goes through this one
+// function so they can't disagree with each other.
+func eventTimestamp(event *v1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
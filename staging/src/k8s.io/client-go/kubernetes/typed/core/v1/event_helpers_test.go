@@ -0,0 +1,121 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestMergeEventListsDedupsOverlappingUIDs(t *testing.T) {
+	a := &v1.EventList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "10"},
+		Items: []v1.Event{
+			{ObjectMeta: metav1.ObjectMeta{UID: "1", Name: "a-1"}},
+			{ObjectMeta: metav1.ObjectMeta{UID: "2", Name: "a-2"}},
+		},
+	}
+	b := &v1.EventList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "20"},
+		Items: []v1.Event{
+			{ObjectMeta: metav1.ObjectMeta{UID: "2", Name: "b-2"}},
+			{ObjectMeta: metav1.ObjectMeta{UID: "3", Name: "b-3"}},
+		},
+	}
+
+	merged := MergeEventLists(a, b)
+
+	if merged.ResourceVersion != "20" {
+		t.Errorf("ResourceVersion = %q, want %q", merged.ResourceVersion, "20")
+	}
+	if len(merged.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3: %v", len(merged.Items), merged.Items)
+	}
+	for _, want := range []types.UID{"1", "2", "3"} {
+		found := false
+		for _, item := range merged.Items {
+			if item.UID == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing event with UID %q in merged list", want)
+		}
+	}
+	for _, item := range merged.Items {
+		if item.UID == "2" && item.Name != "a-2" {
+			t.Errorf("expected first-seen event for UID 2 to win, got %q", item.Name)
+		}
+	}
+}
+
+func TestEventAge(t *testing.T) {
+	now := time.Now()
+	oldest := now.Add(-time.Hour)
+	newest := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		event *v1.Event
+		want  time.Duration
+	}{
+		{
+			name: "series takes precedence over everything else",
+			event: &v1.Event{
+				ObjectMeta:    metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: oldest}},
+				Series:        &v1.EventSeries{LastObservedTime: metav1.MicroTime{Time: newest}},
+				LastTimestamp: metav1.Time{Time: oldest},
+				EventTime:     metav1.MicroTime{Time: oldest},
+			},
+			want: now.Sub(newest),
+		},
+		{
+			name: "LastTimestamp wins for a classic, non-series event",
+			event: &v1.Event{
+				ObjectMeta:    metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: oldest}},
+				LastTimestamp: metav1.Time{Time: newest},
+			},
+			want: now.Sub(newest),
+		},
+		{
+			name: "EventTime is used when LastTimestamp is unset",
+			event: &v1.Event{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: oldest}},
+				EventTime:  metav1.MicroTime{Time: newest},
+			},
+			want: now.Sub(newest),
+		},
+		{
+			name: "falls back to CreationTimestamp when nothing else is set",
+			event: &v1.Event{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: oldest}},
+			},
+			want: now.Sub(oldest),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EventAge(tt.event, now); got != tt.want {
+				t.Errorf("EventAge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchHistogram searches for events about objOrRef and buckets them by
+// timestamp into intervals of bucket, summing series counts, for rendering
+// an activity heatmap. Bucket boundaries are truncated to bucket (UTC), so
+// repeated calls against the same data produce identically-keyed buckets.
+func SearchHistogram(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, bucket time.Duration) (map[time.Time]int, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	histogram := map[time.Time]int{}
+	for i := range list.Items {
+		event := &list.Items[i]
+		ts := eventTimestamp(event)
+		key := ts.UTC().Truncate(bucket)
+		count := 1
+		if event.Series != nil {
+			count = int(event.Series.Count)
+		}
+		histogram[key] += count
+	}
+	return histogram, nil
+}
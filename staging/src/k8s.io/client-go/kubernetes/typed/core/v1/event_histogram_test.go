@@ -0,0 +1,65 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchHistogramBucketsByEventTimestamp(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	body := `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"},"lastTimestamp":"2024-01-01T00:00:05Z"},
+		{"metadata":{"name":"e2","namespace":"ns"},"lastTimestamp":"2024-01-01T00:00:20Z"},
+		{"metadata":{"name":"e3","namespace":"ns"},"lastTimestamp":"2024-01-01T00:01:05Z","series":{"count":3,"lastObservedTime":"2024-01-01T00:01:05.000000Z"}}
+	]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	histogram, err := SearchHistogram(e, scheme, pod, time.Minute)
+	if err != nil {
+		t.Fatalf("SearchHistogram: %v", err)
+	}
+	want := map[time.Time]int{
+		base:                  2,
+		base.Add(time.Minute): 3,
+	}
+	if len(histogram) != len(want) {
+		t.Fatalf("histogram = %v, want %v", histogram, want)
+	}
+	for k, v := range want {
+		if histogram[k] != v {
+			t.Errorf("histogram[%v] = %d, want %d", k, histogram[k], v)
+		}
+	}
+}
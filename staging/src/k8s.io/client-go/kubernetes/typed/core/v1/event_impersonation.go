@@ -0,0 +1,31 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// WithImpersonation makes CreateWithEventNamespaceOptions send this one
+// create with the Impersonate-User/Impersonate-Group headers set to user and
+// groups, so an aggregated controller can record an event as having been
+// made on behalf of the identity it's acting for, without reconfiguring (or
+// cloning) the shared client it otherwise uses for every other operation.
+// The impersonation applies to this create only; it never leaks into other
+// calls made through the same EventInterface.
+func WithImpersonation(user string, groups []string) CreateOption {
+	return func(c *createConfig) {
+		c.impersonateUser = user
+		c.impersonateGroups = groups
+	}
+}
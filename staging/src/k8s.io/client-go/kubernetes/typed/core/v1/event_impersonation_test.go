@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/transport"
+)
+
+func TestWithImpersonationSetsUserAndGroupHeaders(t *testing.T) {
+	var gotUser string
+	var gotGroups []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser = r.Header.Get(transport.ImpersonateUserHeader)
+		gotGroups = r.Header.Values(transport.ImpersonateGroupHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event,
+		WithImpersonation("alice", []string{"system:masters", "developers"}))
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if gotUser != "alice" {
+		t.Errorf("%s = %q, want alice", transport.ImpersonateUserHeader, gotUser)
+	}
+	if len(gotGroups) != 2 || gotGroups[0] != "system:masters" || gotGroups[1] != "developers" {
+		t.Errorf("%s = %v, want [system:masters developers]", transport.ImpersonateGroupHeader, gotGroups)
+	}
+}
+
+func TestWithImpersonationDoesNotLeakIntoUnrelatedCreate(t *testing.T) {
+	var gotUser string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser = r.Header.Get(transport.ImpersonateUserHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := e.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if gotUser != "" {
+		t.Errorf("%s = %q, want empty on a plain create", transport.ImpersonateUserHeader, gotUser)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchIncidents searches for events about objOrRef and clusters them into
+// "incidents": runs of events where consecutive events are no more than gap
+// apart, split wherever the gap to the next event exceeds gap. Incidents are
+// returned oldest-first, each internally ordered oldest-first too. An empty
+// search result yields an empty (non-nil) slice.
+func SearchIncidents(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, gap time.Duration) ([][]*v1.Event, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	events := make([]*v1.Event, len(list.Items))
+	for i := range list.Items {
+		events[i] = &list.Items[i]
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return EventAge(events[i], now) > EventAge(events[j], now)
+	})
+
+	incidents := [][]*v1.Event{}
+	for i, event := range events {
+		if i == 0 || eventTimestamp(events[i-1]).Add(gap).Before(eventTimestamp(event)) {
+			incidents = append(incidents, []*v1.Event{event})
+			continue
+		}
+		last := len(incidents) - 1
+		incidents[last] = append(incidents[last], event)
+	}
+	return incidents, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchIncidentsClustersByGap(t *testing.T) {
+	body := `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"},"lastTimestamp":"2024-01-01T00:00:00Z"},
+		{"metadata":{"name":"e2","namespace":"ns"},"lastTimestamp":"2024-01-01T00:00:30Z"},
+		{"metadata":{"name":"e3","namespace":"ns"},"lastTimestamp":"2024-01-01T00:05:00Z"}
+	]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	incidents, err := SearchIncidents(e, scheme, pod, time.Minute)
+	if err != nil {
+		t.Fatalf("SearchIncidents: %v", err)
+	}
+	if len(incidents) != 2 {
+		t.Fatalf("len(incidents) = %d, want 2: %v", len(incidents), incidents)
+	}
+	if len(incidents[0]) != 2 || incidents[0][0].Name != "e1" || incidents[0][1].Name != "e2" {
+		t.Errorf("incidents[0] = %v, want [e1 e2]", incidents[0])
+	}
+	if len(incidents[1]) != 1 || incidents[1][0].Name != "e3" {
+		t.Errorf("incidents[1] = %v, want [e3]", incidents[1])
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchInconsistentFindsMismatchedInvolvedObjects(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"consistent"},"involvedObject":{"kind":"Pod","name":"p1"}},
+			{"metadata":{"name":"uid-no-kind"},"involvedObject":{"uid":"abc","name":"p1"}},
+			{"metadata":{"name":"kind-no-name"},"involvedObject":{"kind":"Pod"}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	inconsistent, err := SearchInconsistent(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchInconsistent: %v", err)
+	}
+	if len(inconsistent) != 2 {
+		t.Fatalf("len(inconsistent) = %d, want 2", len(inconsistent))
+	}
+	names := map[string]bool{inconsistent[0].Name: true, inconsistent[1].Name: true}
+	if !names["uid-no-kind"] || !names["kind-no-name"] {
+		t.Errorf("inconsistent events = %v, want uid-no-kind and kind-no-name", inconsistent)
+	}
+}
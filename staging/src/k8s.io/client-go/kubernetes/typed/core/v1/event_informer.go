@@ -0,0 +1,53 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// NewObjectEventInformer returns a SharedIndexInformer whose list and watch
+// are both scoped to objOrRef's involved-object field selector, so
+// controllers get a cached, reconnecting, event-handler-driven view of one
+// object's events without assembling the ListWatch themselves.
+func NewObjectEventInformer(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, resync time.Duration) (cache.SharedIndexInformer, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return e.List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return e.Watch(context.TODO(), options)
+		},
+	}
+	return cache.NewSharedIndexInformer(lw, &v1.Event{}, resync, cache.Indexers{}), nil
+}
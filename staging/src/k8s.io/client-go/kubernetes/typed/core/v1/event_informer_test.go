@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewObjectEventInformerRejectsUnreferenceableObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted before a reference is resolved")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+
+	if _, err := NewObjectEventInformer(e, scheme, &v1.Pod{}, 0); err == nil {
+		t.Fatalf("expected an error for an object the scheme can't make a reference for")
+	}
+}
+
+func TestNewObjectEventInformerReturnsAnInformer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	informer, err := NewObjectEventInformer(e, scheme, pod, 0)
+	if err != nil {
+		t.Fatalf("NewObjectEventInformer: %v", err)
+	}
+	if informer == nil {
+		t.Fatalf("informer = nil, want a non-nil SharedIndexInformer")
+	}
+	if informer.GetStore() == nil {
+		t.Fatalf("informer.GetStore() = nil, want a usable store before Run is called")
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// InvolvedObjectRequiredError is returned by CreateWithEventNamespaceOptions
+// when WithInvolvedObjectRequired is set and event's InvolvedObject has
+// neither a Kind nor a Name, so it couldn't identify anything even if the
+// apiserver accepted it.
+type InvolvedObjectRequiredError struct{}
+
+func (e *InvolvedObjectRequiredError) Error() string {
+	return "event has no involvedObject.kind or involvedObject.name set"
+}
+
+// WithInvolvedObjectRequired rejects a create up front, with an
+// *InvolvedObjectRequiredError, when event.InvolvedObject.Kind and
+// event.InvolvedObject.Name are both empty. This is narrower than
+// WithValidation, which also requires Kind alone and other fields: an event
+// whose InvolvedObject carries a Name but no Kind (or vice versa) still
+// passes here, since involvedObjectIsConsistent -- not this option -- is
+// responsible for catching that inconsistency. The default, with this
+// option absent, is unchanged: such an event is sent to the server, which
+// decides whether to accept it.
+func WithInvolvedObjectRequired() CreateOption {
+	return func(c *createConfig) {
+		c.requireInvolvedObject = true
+	}
+}
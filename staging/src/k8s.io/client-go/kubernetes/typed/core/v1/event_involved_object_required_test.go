@@ -0,0 +1,96 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithInvolvedObjectRequiredRejectsEmptyInvolvedObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when involvedObject is empty")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithInvolvedObjectRequired())
+	if _, ok := err.(*InvolvedObjectRequiredError); !ok {
+		t.Fatalf("err = %v, want *InvolvedObjectRequiredError", err)
+	}
+}
+
+func TestWithInvolvedObjectRequiredAllowsKindOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod"},
+	}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithInvolvedObjectRequired()); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+}
+
+func TestWithInvolvedObjectRequiredAllowsNameOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Name: "pod1"},
+	}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithInvolvedObjectRequired()); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+}
+
+func TestWithoutInvolvedObjectRequiredAllowsEmptyInvolvedObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+}
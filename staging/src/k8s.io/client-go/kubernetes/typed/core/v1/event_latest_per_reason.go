@@ -0,0 +1,48 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LatestPerReason searches for events about objOrRef and returns the most
+// recent one for each distinct Reason, keyed by reason. Recency is compared
+// with EventAge, the same "best available timestamp" logic used elsewhere
+// in this package, so it agrees with other helpers about which of two
+// events for the same reason is newer. An object with no matching events
+// returns an empty, non-nil map.
+func LatestPerReason(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (map[string]*v1.Event, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	latest := make(map[string]*v1.Event, len(list.Items))
+	for i := range list.Items {
+		event := &list.Items[i]
+		current, ok := latest[event.Reason]
+		if !ok || EventAge(event, now) < EventAge(current, now) {
+			latest[event.Reason] = event
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLatestPerReasonKeepsMostRecentPerReason(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"BackOff","lastTimestamp":"2024-01-01T00:00:00Z"},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"BackOff","lastTimestamp":"2024-01-01T00:05:00Z"},
+			{"metadata":{"name":"e3","namespace":"ns"},"reason":"Pulled","lastTimestamp":"2024-01-01T00:01:00Z"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	latest, err := LatestPerReason(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("LatestPerReason: %v", err)
+	}
+	if len(latest) != 2 {
+		t.Fatalf("len(latest) = %d, want 2: %+v", len(latest), latest)
+	}
+	if latest["BackOff"].Name != "e2" {
+		t.Errorf("latest[BackOff].Name = %q, want e2", latest["BackOff"].Name)
+	}
+	if latest["Pulled"].Name != "e3" {
+		t.Errorf("latest[Pulled].Name = %q, want e3", latest["Pulled"].Name)
+	}
+}
+
+func TestLatestPerReasonReturnsEmptyMapForNoEvents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	latest, err := LatestPerReason(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("LatestPerReason: %v", err)
+	}
+	if latest == nil || len(latest) != 0 {
+		t.Fatalf("latest = %v, want a non-nil empty map", latest)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// LinkAnnotation is stamped on every event belonging to the same logical
+// operation (e.g. the start and end events bracketing a long-running
+// action), so SearchByLink can later retrieve them as a group even though
+// they have no other field in common.
+const LinkAnnotation = "events.k8s.io/link-id"
+
+// CreateLinkedStart creates event annotated with a freshly generated link
+// ID, returning that ID alongside the created event so the caller can pass
+// it to CreateLinkedEnd once the operation it's tracking finishes.
+func CreateLinkedStart(e EventInterface, event *v1.Event) (linkID string, created *v1.Event, err error) {
+	linkID = string(uuid.NewUUID())
+	withLink := *event
+	withLink.Annotations = cloneAndSetAnnotation(event.Annotations, LinkAnnotation, linkID)
+	created, err = e.CreateWithEventNamespace(&withLink)
+	if err != nil {
+		return "", nil, err
+	}
+	return linkID, created, nil
+}
+
+// CreateLinkedEnd creates event annotated with linkID, the same link ID
+// returned by the CreateLinkedStart call that began the operation event is
+// concluding.
+func CreateLinkedEnd(e EventInterface, linkID string, event *v1.Event) (*v1.Event, error) {
+	withLink := *event
+	withLink.Annotations = cloneAndSetAnnotation(event.Annotations, LinkAnnotation, linkID)
+	return e.CreateWithEventNamespace(&withLink)
+}
+
+// SearchByLink lists every event in e's namespace carrying linkID in
+// LinkAnnotation, so callers can retrieve a whole CreateLinkedStart /
+// CreateLinkedEnd pair (and anything created in between) as a group.
+// Annotations aren't field-selectable, so this filters client-side.
+func SearchByLink(e EventInterface, linkID string) (*v1.EventList, error) {
+	all, err := e.List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	filtered := all.DeepCopy()
+	filtered.Items = nil
+	for i := range all.Items {
+		if all.Items[i].Annotations[LinkAnnotation] == linkID {
+			filtered.Items = append(filtered.Items, all.Items[i])
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateLinkedStartAndEndShareAGeneratedLinkID(t *testing.T) {
+	var sent []v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event v1.Event
+		json.NewDecoder(r.Body).Decode(&event)
+		sent = append(sent, event)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(event)
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	start := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	linkID, created, err := CreateLinkedStart(e, start)
+	if err != nil {
+		t.Fatalf("CreateLinkedStart: %v", err)
+	}
+	if linkID == "" {
+		t.Fatalf("linkID is empty")
+	}
+	if created.Annotations[LinkAnnotation] != linkID {
+		t.Errorf("created annotation = %q, want %q", created.Annotations[LinkAnnotation], linkID)
+	}
+
+	end := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e2", Namespace: "ns"}}
+	if _, err := CreateLinkedEnd(e, linkID, end); err != nil {
+		t.Fatalf("CreateLinkedEnd: %v", err)
+	}
+
+	if len(sent) != 2 {
+		t.Fatalf("len(sent) = %d, want 2", len(sent))
+	}
+	if sent[0].Annotations[LinkAnnotation] != sent[1].Annotations[LinkAnnotation] {
+		t.Errorf("start and end events don't share a link ID: %q vs %q", sent[0].Annotations[LinkAnnotation], sent[1].Annotations[LinkAnnotation])
+	}
+	if start.Annotations != nil {
+		t.Errorf("CreateLinkedStart mutated the caller's event: %v", start.Annotations)
+	}
+}
+
+func TestSearchByLinkFiltersByAnnotation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns","annotations":{"events.k8s.io/link-id":"abc"}}},
+			{"metadata":{"name":"e2","namespace":"ns","annotations":{"events.k8s.io/link-id":"xyz"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	list, err := SearchByLink(e, "abc")
+	if err != nil {
+		t.Fatalf("SearchByLink: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Fatalf("SearchByLink result = %v, want [e1]", list.Items)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MessageTruncatedAnnotation marks an event returned by
+// SearchWithMessagePreview whose Message was shortened to fit maxLen, so a
+// caller that renders the preview can tell it's incomplete without
+// recomparing lengths.
+const MessageTruncatedAnnotation = "events.k8s.io/message-truncated"
+
+// SearchWithMessagePreview searches for events about objOrRef and returns a
+// copy of the result with every Message truncated to at most maxLen runes
+// (runes, not bytes, so the preview doesn't split a multi-byte character),
+// appending an ellipsis in place of the dropped tail and stamping
+// MessageTruncatedAnnotation on any event that was shortened. The objects on
+// the server are never modified; only the returned copies carry the
+// truncated message and annotation.
+func SearchWithMessagePreview(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, maxLen int) (*v1.EventList, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := list.DeepCopy()
+	for i := range preview.Items {
+		event := &preview.Items[i]
+		truncated, didTruncate := truncateMessagePreview(event.Message, maxLen)
+		event.Message = truncated
+		if didTruncate {
+			event.Annotations = cloneAndSetAnnotation(event.Annotations, MessageTruncatedAnnotation, "true")
+		}
+	}
+	return preview, nil
+}
+
+// truncateMessagePreview shortens message to at most maxLen runes, replacing
+// the dropped tail with an ellipsis, and reports whether it did so.
+func truncateMessagePreview(message string, maxLen int) (string, bool) {
+	runes := []rune(message)
+	if len(runes) <= maxLen {
+		return message, false
+	}
+	const ellipsis = "..."
+	ellipsisRunes := []rune(ellipsis)
+	if maxLen <= len(ellipsisRunes) {
+		return string(ellipsisRunes[:maxLen]), true
+	}
+	return string(runes[:maxLen-len(ellipsisRunes)]) + ellipsis, true
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTruncateMessagePreview(t *testing.T) {
+	cases := []struct {
+		message       string
+		maxLen        int
+		want          string
+		wantTruncated bool
+	}{
+		{"short", 10, "short", false},
+		{"this is a long message", 10, "this is...", true},
+		{"hello", 2, "..", true},
+	}
+	for _, tc := range cases {
+		got, truncated := truncateMessagePreview(tc.message, tc.maxLen)
+		if got != tc.want || truncated != tc.wantTruncated {
+			t.Errorf("truncateMessagePreview(%q, %d) = (%q, %v), want (%q, %v)", tc.message, tc.maxLen, got, truncated, tc.want, tc.wantTruncated)
+		}
+	}
+}
+
+func TestSearchWithMessagePreviewLeavesServerCopyUntouched(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"},"message":"this is a long message"}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	preview, err := SearchWithMessagePreview(e, scheme, pod, 10)
+	if err != nil {
+		t.Fatalf("SearchWithMessagePreview: %v", err)
+	}
+	if len(preview.Items) != 1 {
+		t.Fatalf("len(preview.Items) = %d, want 1", len(preview.Items))
+	}
+	if preview.Items[0].Message != "this is..." {
+		t.Errorf("preview message = %q, want %q", preview.Items[0].Message, "this is...")
+	}
+	if preview.Items[0].Annotations[MessageTruncatedAnnotation] != "true" {
+		t.Errorf("missing truncation annotation: %v", preview.Items[0].Annotations)
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "time"
+
+// MetricsRecorder receives a sample for every event write this package
+// performs, for wiring into Prometheus or another metrics backend.
+type MetricsRecorder interface {
+	// ObserveWrite is called once per CreateWithEventNamespace,
+	// UpdateWithEventNamespace or PatchWithEventNamespace call (under any of
+	// their Context/OfType/ByName variants) with verb set to "create",
+	// "update" or "patch", how long the call took, and its error (nil on
+	// success).
+	ObserveWrite(verb string, duration time.Duration, err error)
+}
+
+// EventWriteMetricsRecorder, if non-nil, is invoked around every event
+// write this package performs. It defaults to nil, so leaving it unset
+// costs nothing beyond the one nil check per call.
+var EventWriteMetricsRecorder MetricsRecorder
+
+// observeWrite reports duration and err for verb to EventWriteMetricsRecorder,
+// if one is set.
+func observeWrite(verb string, start time.Time, err error) {
+	if EventWriteMetricsRecorder == nil {
+		return
+	}
+	EventWriteMetricsRecorder.ObserveWrite(verb, time.Since(start), err)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type recordedWrite struct {
+	verb     string
+	duration time.Duration
+	err      error
+}
+
+type fakeMetricsRecorder struct {
+	writes []recordedWrite
+}
+
+func (r *fakeMetricsRecorder) ObserveWrite(verb string, duration time.Duration, err error) {
+	r.writes = append(r.writes, recordedWrite{verb: verb, duration: duration, err: err})
+}
+
+func withEventWriteMetricsRecorder(t *testing.T, rec MetricsRecorder) {
+	t.Helper()
+	old := EventWriteMetricsRecorder
+	EventWriteMetricsRecorder = rec
+	t.Cleanup(func() { EventWriteMetricsRecorder = old })
+}
+
+func TestEventWriteMetricsRecorderObservesCreateUpdatePatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	rec := &fakeMetricsRecorder{}
+	withEventWriteMetricsRecorder(t, rec)
+
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := e.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if _, err := e.UpdateWithEventNamespace(event); err != nil {
+		t.Fatalf("UpdateWithEventNamespace: %v", err)
+	}
+	if _, err := e.PatchWithEventNamespace(event, []byte(`{}`)); err != nil {
+		t.Fatalf("PatchWithEventNamespace: %v", err)
+	}
+
+	if len(rec.writes) != 3 {
+		t.Fatalf("len(rec.writes) = %d, want 3: %+v", len(rec.writes), rec.writes)
+	}
+	wantVerbs := []string{"create", "update", "patch"}
+	for i, want := range wantVerbs {
+		if rec.writes[i].verb != want {
+			t.Fatalf("rec.writes[%d].verb = %q, want %q", i, rec.writes[i].verb, want)
+		}
+		if rec.writes[i].err != nil {
+			t.Fatalf("rec.writes[%d].err = %v, want nil", i, rec.writes[i].err)
+		}
+	}
+}
+
+func TestEventWriteMetricsRecorderObservesError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	rec := &fakeMetricsRecorder{}
+	withEventWriteMetricsRecorder(t, rec)
+
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+	if _, err := e.CreateWithEventNamespace(event); err == nil {
+		t.Fatalf("expected a namespace mismatch error")
+	}
+	if len(rec.writes) != 1 || rec.writes[0].err == nil {
+		t.Fatalf("rec.writes = %+v, want one write with a non-nil error", rec.writes)
+	}
+}
+
+func TestNilEventWriteMetricsRecorderIsNoop(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	withEventWriteMetricsRecorder(t, nil)
+
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := e.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "errors"
+
+// ErrNamespaceMismatch is wrapped into every error CreateWithEventNamespace,
+// UpdateWithEventNamespace, PatchWithEventNamespace and Search (and their
+// DryRun, Timeout and MutatingEventClient variants) return when an event's
+// (or involved object's) namespace doesn't match this client's configured
+// namespace, so callers can use errors.Is(err, ErrNamespaceMismatch) instead
+// of string-matching the message.
+var ErrNamespaceMismatch = errors.New("event namespace does not match client namespace")
+
+// namespaceMismatchError wraps ErrNamespaceMismatch behind a caller-supplied
+// message, so the message text returned to callers is unchanged from before
+// ErrNamespaceMismatch existed while still supporting errors.Is.
+type namespaceMismatchError struct {
+	msg string
+}
+
+func (e *namespaceMismatchError) Error() string { return e.msg }
+
+func (e *namespaceMismatchError) Unwrap() error { return ErrNamespaceMismatch }
+
+// newNamespaceMismatchError builds a namespaceMismatchError with msg as its
+// exact Error() text.
+func newNamespaceMismatchError(msg string) error {
+	return &namespaceMismatchError{msg: msg}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newScopedTestEventsClient(t *testing.T, srv *httptest.Server, ns string) *events {
+	base := newTestEventsClient(t, srv)
+	return &events{client: base.client, ns: ns}
+}
+
+func TestCreateWithEventNamespaceMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	_, err := e.CreateWithEventNamespace(event)
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+	if err.Error() != "can't create an event with namespace 'other' in namespace 'configured'" {
+		t.Fatalf("unexpected message: %v", err)
+	}
+}
+
+func TestUpdateWithEventNamespaceMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	_, err := e.UpdateWithEventNamespace(event)
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+}
+
+func TestPatchWithEventNamespaceMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	_, err := e.PatchWithEventNamespace(event, []byte(`{}`))
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+}
+
+func TestSearchNamespaceMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "other", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	_, err := e.Search(scheme, pod)
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+}
+
+func TestCreateWithEventNamespaceDryRunMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	_, err := CreateWithEventNamespaceDryRun(e, event)
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+}
+
+func TestCreateWithEventNamespaceTimeoutMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	_, err := CreateWithEventNamespaceTimeout(e, event, time.Second)
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+}
+
+func TestMutatingEventClientCreateMismatchIsErrNamespaceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted on namespace mismatch")
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "configured")
+	m := NewMutatingEventClient(e, nil)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "other"}}
+
+	_, err := m.CreateWithEventNamespace(event)
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("errors.Is(err, ErrNamespaceMismatch) = false, err = %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// AllowNamespaceMismatch, when true, skips the guard in
+// CreateWithEventNamespaceChecked, PatchWithEventNamespaceAllowingMismatch
+// and SearchChecked that normally rejects an event/object whose namespace
+// differs from the client's configured namespace. It exists for proxies
+// that legitimately forward events across namespaces on behalf of a single
+// shared client.
+//
+// This bypasses a safety guard meant to catch callers that point a
+// namespace-scoped client at the wrong namespace by mistake, so it is
+// advanced and off by default. With it set, the event's (or object's) own
+// namespace always wins over the client's. UpdateWithEventNamespace never
+// had this guard, so it is unaffected.
+type AllowNamespaceMismatch bool
+
+// checkNamespaceMatch is the guard CreateWithEventNamespace, PatchWithEventNamespace
+// and Search normally apply unconditionally; it is factored out here so
+// AllowNamespaceMismatch can skip it without duplicating the client REST
+// plumbing.
+func checkNamespaceMatch(clientNs, objectNs string, allow AllowNamespaceMismatch, verb string) error {
+	if allow {
+		return nil
+	}
+	if clientNs != "" && objectNs != clientNs {
+		return fmt.Errorf("can't %s an event with namespace '%v' in namespace '%v'", verb, objectNs, clientNs)
+	}
+	return nil
+}
+
+// CreateWithEventNamespaceChecked is CreateWithEventNamespace, except that
+// when allow is true the namespace-mismatch guard is skipped.
+func CreateWithEventNamespaceChecked(e EventInterface, event *v1.Event, allow AllowNamespaceMismatch) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.CreateWithEventNamespace(event)
+	}
+	if err := checkNamespaceMatch(impl.ns, event.Namespace, allow, "create"); err != nil {
+		return nil, err
+	}
+	result := &v1.Event{}
+	err := impl.client.Post().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource("events").
+		Body(event).
+		Do(context.TODO()).
+		Into(result)
+	return result, err
+}
+
+// PatchWithEventNamespaceAllowingMismatch is PatchWithEventNamespace, except
+// that when allow is true the namespace-mismatch guard is skipped. It is
+// named distinctly from PatchWithEventNamespaceChecked, which guards against
+// patching immutable fields rather than namespace mismatch.
+func PatchWithEventNamespaceAllowingMismatch(e EventInterface, incompleteEvent *v1.Event, data []byte, allow AllowNamespaceMismatch) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.PatchWithEventNamespace(incompleteEvent, data)
+	}
+	if err := checkNamespaceMatch(impl.ns, incompleteEvent.Namespace, allow, "patch"); err != nil {
+		return nil, err
+	}
+	result := &v1.Event{}
+	err := impl.client.Patch(types.StrategicMergePatchType).
+		NamespaceIfScoped(incompleteEvent.Namespace, len(incompleteEvent.Namespace) > 0).
+		Resource("events").
+		Name(incompleteEvent.Name).
+		Body(data).
+		Do(context.TODO()).
+		Into(result)
+	return result, err
+}
+
+// SearchChecked is Search, except that when allow is true the
+// namespace-mismatch guard is skipped.
+func SearchChecked(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, allow AllowNamespaceMismatch) (*v1.EventList, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	impl, ok := e.(*events)
+	if !ok {
+		return e.Search(scheme, objOrRef)
+	}
+	if err := checkNamespaceMatch(impl.ns, objRef.Namespace, allow, "search for"); err != nil {
+		return nil, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	return e.List(context.TODO(), metav1.ListOptions{FieldSelector: fieldSelector})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "testing"
+
+func TestCheckNamespaceMatchRejectsMismatchByDefault(t *testing.T) {
+	if err := checkNamespaceMatch("configured", "other", false, "create"); err == nil {
+		t.Fatal("expected a namespace-mismatch error with AllowNamespaceMismatch unset")
+	}
+}
+
+func TestCheckNamespaceMatchAllowsMismatchWhenSet(t *testing.T) {
+	if err := checkNamespaceMatch("configured", "other", true, "create"); err != nil {
+		t.Fatalf("AllowNamespaceMismatch should skip the guard, got: %v", err)
+	}
+}
+
+func TestCheckNamespaceMatchAllowsSameNamespaceEitherWay(t *testing.T) {
+	if err := checkNamespaceMatch("configured", "configured", false, "create"); err != nil {
+		t.Fatalf("matching namespaces should never error, got: %v", err)
+	}
+	if err := checkNamespaceMatch("", "anything", false, "create"); err != nil {
+		t.Fatalf("an unscoped client should never error, got: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// NewEvent builds a ready-to-Create event about objOrRef: it resolves
+// objOrRef via GetReference into InvolvedObject, stamps EventTime with
+// metav1.NowMicro(), and generates a name, so callers don't have to
+// hand-assemble every field the apiserver requires (a frequent source of
+// rejected events). eventType must be v1.EventTypeNormal or
+// v1.EventTypeWarning; anything else is rejected before GetReference is
+// even called.
+//
+// This package doesn't vendor k8s.io/api/events/v1beta1, so unlike an
+// events.k8s.io-native constructor this returns a core *v1.Event; pass it to
+// ConvertFromCoreEvent first if a v1beta1.Event is actually needed.
+func NewEvent(scheme *runtime.Scheme, objOrRef runtime.Object, eventType, reason, action, message, reportingController, reportingInstance string) (*v1.Event, error) {
+	if eventType != v1.EventTypeNormal && eventType != v1.EventTypeWarning {
+		return nil, fmt.Errorf("invalid event type %q: must be %q or %q", eventType, v1.EventTypeNormal, v1.EventTypeWarning)
+	}
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	namespace := objRef.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	now := metav1.NowMicro()
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s.", objRef.Name),
+			Namespace:    namespace,
+		},
+		InvolvedObject:      *objRef,
+		EventTime:           now,
+		FirstTimestamp:      metav1.NewTime(now.Time),
+		LastTimestamp:       metav1.NewTime(now.Time),
+		Count:               1,
+		Type:                eventType,
+		Reason:              reason,
+		Action:              action,
+		Message:             message,
+		ReportingController: reportingController,
+		ReportingInstance:   reportingInstance,
+	}, nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewEventPopulatesRequiredFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	event, err := NewEvent(scheme, pod, v1.EventTypeWarning, "Failed", "Scheduling", "could not schedule", "my-controller", "instance-1")
+	if err != nil {
+		t.Fatalf("NewEvent: %v", err)
+	}
+	if event.GenerateName == "" {
+		t.Fatalf("GenerateName is empty, want a generated name prefix")
+	}
+	if event.Namespace != "ns" {
+		t.Fatalf("Namespace = %q, want ns", event.Namespace)
+	}
+	if event.InvolvedObject.Name != "p1" || event.InvolvedObject.UID != "u1" {
+		t.Fatalf("InvolvedObject = %+v, want p1/u1", event.InvolvedObject)
+	}
+	if event.EventTime.IsZero() {
+		t.Fatalf("EventTime is zero, want metav1.NowMicro()")
+	}
+	if event.ReportingController != "my-controller" || event.ReportingInstance != "instance-1" {
+		t.Fatalf("reporting fields = %q/%q, want my-controller/instance-1", event.ReportingController, event.ReportingInstance)
+	}
+	if event.Reason != "Failed" || event.Action != "Scheduling" || event.Message != "could not schedule" {
+		t.Fatalf("event = %+v, missing expected fields", event)
+	}
+}
+
+func TestNewEventRejectsInvalidEventType(t *testing.T) {
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	if _, err := NewEvent(scheme, pod, "Bogus", "Failed", "", "", "", ""); err == nil {
+		t.Fatalf("expected an error for an invalid event type")
+	}
+}
+
+func TestNewEventErrorsWithoutCallingGetReferenceOnInvalidType(t *testing.T) {
+	scheme := runtime.NewScheme()
+	// No types registered, so GetReference would fail too -- this confirms
+	// the eventType check runs first.
+	if _, err := NewEvent(scheme, &v1.Pod{}, "Bogus", "Failed", "", "", "", ""); err == nil {
+		t.Fatalf("expected an error for an invalid event type")
+	}
+}
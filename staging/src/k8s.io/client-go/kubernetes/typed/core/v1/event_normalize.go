@@ -0,0 +1,52 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NormalizeLegacyEvent maps an event still using the pre-aggregation legacy
+// fields (source.component, firstTimestamp/lastTimestamp/count) onto the
+// modern reporting fields (reportingController/reportingInstance, series),
+// so aggregation code can read one shape regardless of which client
+// recorded the event. It never overwrites a modern field that is already
+// set, and it returns a copy: in is never mutated.
+//
+//   - source.component is copied to reportingController when
+//     reportingController is empty.
+//   - firstTimestamp/lastTimestamp/count are folded into series when series
+//     is nil and count indicates more than one occurrence; lastTimestamp
+//     becomes series.lastObservedTime.
+func NormalizeLegacyEvent(in *v1.Event) *v1.Event {
+	out := in.DeepCopy()
+	if out.ReportingController == "" && out.Source.Component != "" {
+		out.ReportingController = out.Source.Component
+	}
+	if out.Series == nil && out.Count > 1 {
+		lastObserved := out.LastTimestamp
+		if lastObserved.IsZero() {
+			lastObserved = out.FirstTimestamp
+		}
+		out.Series = &v1.EventSeries{
+			Count:            out.Count,
+			LastObservedTime: metav1.MicroTime{Time: lastObserved.Time},
+		}
+	}
+	return out
+}
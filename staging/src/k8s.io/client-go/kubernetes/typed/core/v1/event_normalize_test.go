@@ -0,0 +1,74 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNormalizeLegacyEventMapsSourceAndCount(t *testing.T) {
+	first := metav1.NewTime(time.Now().Add(-time.Hour))
+	last := metav1.NewTime(time.Now())
+	legacy := &v1.Event{
+		Source:         v1.EventSource{Component: "kubelet"},
+		FirstTimestamp: first,
+		LastTimestamp:  last,
+		Count:          5,
+	}
+
+	out := NormalizeLegacyEvent(legacy)
+
+	if out.ReportingController != "kubelet" {
+		t.Errorf("ReportingController = %q, want %q", out.ReportingController, "kubelet")
+	}
+	if out.Series == nil {
+		t.Fatal("Series = nil, want populated from legacy count/timestamp")
+	}
+	if out.Series.Count != 5 {
+		t.Errorf("Series.Count = %d, want 5", out.Series.Count)
+	}
+	if !out.Series.LastObservedTime.Time.Equal(last.Time) {
+		t.Errorf("Series.LastObservedTime = %v, want %v", out.Series.LastObservedTime.Time, last.Time)
+	}
+
+	// The input must not be mutated.
+	if legacy.ReportingController != "" || legacy.Series != nil {
+		t.Errorf("NormalizeLegacyEvent mutated its input: %+v", legacy)
+	}
+}
+
+func TestNormalizeLegacyEventPreservesModernFields(t *testing.T) {
+	modern := &v1.Event{
+		Source:              v1.EventSource{Component: "kubelet"},
+		ReportingController: "kubelet-modern",
+		Series:              &v1.EventSeries{Count: 9},
+		Count:               2,
+	}
+
+	out := NormalizeLegacyEvent(modern)
+
+	if out.ReportingController != "kubelet-modern" {
+		t.Errorf("ReportingController = %q, want existing value preserved", out.ReportingController)
+	}
+	if out.Series.Count != 9 {
+		t.Errorf("Series.Count = %d, want existing series preserved", out.Series.Count)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchByObjectSelector searches for events about every object listFunc
+// returns, merging and deduping the results via MergeEventLists. Keeping
+// object enumeration in the caller (e.g. backed by a Pod lister scoped to a
+// label selector) avoids this package depending on every resource's client
+// while still giving callers bulk-search ergonomics on top of Search.
+func SearchByObjectSelector(e EventInterface, scheme *runtime.Scheme, listFunc func() ([]runtime.Object, error)) (*v1.EventList, error) {
+	objects, err := listFunc()
+	if err != nil {
+		return nil, err
+	}
+	lists := make([]*v1.EventList, 0, len(objects))
+	for _, obj := range objects {
+		list, err := e.Search(scheme, obj)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+	return MergeEventLists(lists...), nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchByObjectSelectorMergesResultsAcrossObjects(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns","uid":"ev1"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e2","namespace":"ns","uid":"ev2"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod1 := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	pod2 := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p2", Namespace: "ns", UID: "u2"}}
+
+	list, err := SearchByObjectSelector(e, scheme, func() ([]runtime.Object, error) {
+		return []runtime.Object{pod1, pod2}, nil
+	})
+	if err != nil {
+		t.Fatalf("SearchByObjectSelector: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("len(list.Items) = %d, want 2: %v", len(list.Items), list.Items)
+	}
+}
+
+func TestSearchByObjectSelectorPropagatesListFuncError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when listFunc fails")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	wantErr := errors.New("boom")
+
+	_, err := SearchByObjectSelector(e, scheme, func() ([]runtime.Object, error) {
+		return nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("err = %v, want %v", err, wantErr)
+	}
+}
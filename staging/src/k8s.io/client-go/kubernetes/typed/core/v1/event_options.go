@@ -0,0 +1,358 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxEventMessageBytes is the message length the apiserver enforces on Event
+// objects. Callers that want to pre-truncate a message themselves, instead of
+// relying on WithMessageTruncation, can use this as the limit.
+const MaxEventMessageBytes = 1024
+
+// createConfig accumulates the behavior requested through CreateOptions
+// passed to CreateWithEventNamespaceOptions.
+type createConfig struct {
+	truncateMessage       bool
+	limiter               *rate.Limiter
+	dropOnExceeded        bool
+	ownerRef              *metav1.OwnerReference
+	related               *v1.ObjectReference
+	validate              bool
+	requireInvolvedObject bool
+	requireKnownReason    bool
+	correlationID         string
+	redactor              func(string) string
+	userAgent             string
+	partitionLabelKey     string
+	partitionLabelValue   string
+	priorityHint          string
+	traceparent           string
+	recentBuffer          *RecentBuffer
+	referenceRewriter     ReferenceRewriter
+	maxReasonsPerObject   int
+	circuitBreaker        *CircuitBreaker
+	observedTimeRange     *observedTimeRange
+	compressMessage       bool
+	exemplarRecorder      func(*v1.Event)
+	impersonateUser       string
+	impersonateGroups     []string
+	timeout               time.Duration
+}
+
+// DroppedEventCreatesMetric counts creates skipped by WithRateLimiter in
+// drop mode. It defaults to a no-op and can be replaced by a caller that
+// wants to surface the count (e.g. wiring it to a Prometheus counter) before
+// any events are created.
+var DroppedEventCreatesMetric CounterMetric = noopCounter{}
+
+// CounterMetric is a minimal counter, satisfied by most metrics libraries,
+// used to report events dropped by the create-time rate limiter.
+type CounterMetric interface {
+	Inc()
+}
+
+type noopCounter struct{}
+
+func (noopCounter) Inc() {}
+
+// CreateOption customizes the behavior of CreateWithEventNamespaceOptions.
+type CreateOption func(*createConfig)
+
+// WithMessageTruncation truncates an overly long event message to
+// MaxEventMessageBytes (with an ellipsis) instead of letting the create fail
+// with a validation error. The default behavior, when this option is not
+// supplied, is to preserve today's behavior and return the server's error.
+func WithMessageTruncation() CreateOption {
+	return func(c *createConfig) {
+		c.truncateMessage = true
+	}
+}
+
+// WithRateLimiter makes CreateWithEventNamespaceOptions consult limiter
+// before creating the event. If dropOnExceeded is true, a create that would
+// exceed the limit is silently skipped (DroppedEventCreatesMetric is
+// incremented) and the call returns (nil, nil); otherwise the call blocks
+// until the limiter admits it. This is controller-side protection, distinct
+// from the apiserver's own throttling, for controllers that can emit bursts
+// of events large enough to overwhelm etcd. The default, with no limiter
+// configured, is unchanged: every create goes straight to the server.
+func WithRateLimiter(limiter *rate.Limiter, dropOnExceeded bool) CreateOption {
+	return func(c *createConfig) {
+		c.limiter = limiter
+		c.dropOnExceeded = dropOnExceeded
+	}
+}
+
+// WithOwnerReference sets owner on the created event's OwnerReferences, so
+// the apiserver's garbage collector deletes the event when owner is deleted
+// instead of requiring a separate event-reaper. OwnerReference has no
+// namespace of its own; it is always interpreted as living in the event's
+// namespace, matching how the GC controller resolves owners for namespaced
+// objects. Note this is a convenience on top of, not a replacement for, the
+// apiserver's own event TTL: the event is still eligible for TTL cleanup
+// before its owner is deleted.
+func WithOwnerReference(owner metav1.OwnerReference) CreateOption {
+	return func(c *createConfig) {
+		c.ownerRef = &owner
+	}
+}
+
+// RelatedObjectAnnotation is the standard annotation WithRelated stamps onto
+// a created event to record a related object, for composite resources where
+// an event about one object (e.g. a Pod) is really about another (e.g. its
+// PVC). The value is "kind/namespace/name".
+const RelatedObjectAnnotation = "events.k8s.io/related-object"
+
+// WithRelated records ref in the RelatedObjectAnnotation, so SearchByRelated
+// can later find events carrying it. Annotations aren't field-selectable, so
+// this is a client-side-filterable breadcrumb rather than an indexed field.
+func WithRelated(ref *v1.ObjectReference) CreateOption {
+	return func(c *createConfig) {
+		c.related = ref
+	}
+}
+
+// CorrelationIDAnnotation is the standard annotation WithCorrelationID stamps
+// onto a created event, so our distributed trace can join an event with the
+// logs produced by the same request without a new API field.
+const CorrelationIDAnnotation = "events.k8s.io/correlation-id"
+
+// WithCorrelationID stamps id into CorrelationIDAnnotation on the created
+// event, so SearchByCorrelationID can later join it with logs from the same
+// traced request.
+func WithCorrelationID(id string) CreateOption {
+	return func(c *createConfig) {
+		c.correlationID = id
+	}
+}
+
+// WithRedactor applies redact to the event's message (and annotation values)
+// before the POST, without altering the event the caller still holds. It is
+// opt-in and off by default.
+func WithRedactor(redact func(string) string) CreateOption {
+	return func(c *createConfig) {
+		c.redactor = redact
+	}
+}
+
+// WithDefaultRedaction applies defaultRedactor, which masks strings matching
+// common token/secret patterns (e.g. "Bearer <token>", AWS-style access
+// keys), via WithRedactor.
+func WithDefaultRedaction() CreateOption {
+	return WithRedactor(defaultRedactor)
+}
+
+// WithUserAgent sets a per-request User-Agent header on the create, so
+// audit and rate-limit-attribution tooling can tell which controller emitted
+// which events even though they share one client. The default, with this
+// option absent, is the client's own configured User-Agent, unchanged.
+func WithUserAgent(ua string) CreateOption {
+	return func(c *createConfig) {
+		c.userAgent = ua
+	}
+}
+
+// WithValidation runs ValidateEventStrict before the POST and, if it reports
+// any problems, fails the create with their combined error instead of
+// sending a request the apiserver would reject anyway.
+func WithValidation() CreateOption {
+	return func(c *createConfig) {
+		c.validate = true
+	}
+}
+
+// WithExemplarRecorder calls record with every successfully created event
+// whose Type is v1.EventTypeWarning, so a controller can bridge warning
+// events to a metrics system's exemplars (e.g. attaching the event as an
+// exemplar on the Prometheus counter it's about to increment) without
+// threading a recorder through every call site that creates events. It is
+// not called for non-Warning events or for creates that fail. The default,
+// with this option absent, records nothing.
+func WithExemplarRecorder(record func(*v1.Event)) CreateOption {
+	return func(c *createConfig) {
+		c.exemplarRecorder = record
+	}
+}
+
+// WithTimeout sets d as the server-side Timeout on the create request, via
+// CreateWithEventNamespaceTimeout, so a struggling apiserver returns a
+// deadline-exceeded error instead of stalling the caller for the client's
+// full overall timeout.
+func WithTimeout(d time.Duration) CreateOption {
+	return func(c *createConfig) {
+		c.timeout = d
+	}
+}
+
+// CreateWithEventNamespaceOptions is the functional-options entry point for
+// creating an event. It behaves exactly like CreateWithEventNamespace except
+// that the supplied CreateOptions can opt into additional, non-default
+// behavior such as WithMessageTruncation or WithRateLimiter.
+func CreateWithEventNamespaceOptions(ctx context.Context, e EventInterface, event *v1.Event, opts ...CreateOption) (*v1.Event, error) {
+	cfg := &createConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.referenceRewriter != nil {
+		rewritten := *event
+		involvedObjectCopy := event.InvolvedObject
+		rewritten.InvolvedObject = *cfg.referenceRewriter(&involvedObjectCopy)
+		event = &rewritten
+	}
+	if cfg.requireKnownReason {
+		if err := checkKnownReason(event.Reason); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.maxReasonsPerObject > 0 {
+		reasons, err := DistinctReasonsForObject(ctx, e, nil, &event.InvolvedObject)
+		if err != nil {
+			return nil, err
+		}
+		if len(reasons) >= cfg.maxReasonsPerObject && !containsString(reasons, event.Reason) {
+			return nil, &MaxReasonsPerObjectError{Max: cfg.maxReasonsPerObject}
+		}
+	}
+	if cfg.observedTimeRange != nil {
+		stamped, err := cfg.observedTimeRange.apply(event)
+		if err != nil {
+			return nil, err
+		}
+		event = stamped
+	}
+	if cfg.requireInvolvedObject && event.InvolvedObject.Kind == "" && event.InvolvedObject.Name == "" {
+		return nil, &InvolvedObjectRequiredError{}
+	}
+	if cfg.validate {
+		if errs := ValidateEventStrict(event); len(errs) > 0 {
+			return nil, errs.ToAggregate()
+		}
+	}
+	if cfg.truncateMessage && len(event.Message) > MaxEventMessageBytes {
+		truncated := *event
+		const ellipsis = "..."
+		truncated.Message = event.Message[:MaxEventMessageBytes-len(ellipsis)] + ellipsis
+		event = &truncated
+	}
+	if cfg.ownerRef != nil {
+		if event.OwnerReferences != nil {
+			return nil, fmt.Errorf("event %s/%s already has owner references set", event.Namespace, event.Name)
+		}
+		withOwner := *event
+		withOwner.OwnerReferences = []metav1.OwnerReference{*cfg.ownerRef}
+		event = &withOwner
+	}
+	if cfg.related != nil {
+		withRelated := *event
+		withRelated.Annotations = cloneAndSetAnnotation(event.Annotations, RelatedObjectAnnotation, relatedObjectAnnotationValue(cfg.related))
+		event = &withRelated
+	}
+	if cfg.correlationID != "" {
+		withCorrelation := *event
+		withCorrelation.Annotations = cloneAndSetAnnotation(event.Annotations, CorrelationIDAnnotation, cfg.correlationID)
+		event = &withCorrelation
+	}
+	if cfg.traceparent != "" {
+		withTrace := *event
+		withTrace.Annotations = cloneAndSetAnnotation(event.Annotations, TraceContextAnnotation, cfg.traceparent)
+		event = &withTrace
+	}
+	if cfg.partitionLabelKey != "" {
+		withPartition, err := applyPartitionLabel(event, cfg.partitionLabelKey, cfg.partitionLabelValue)
+		if err != nil {
+			return nil, err
+		}
+		event = withPartition
+	}
+	if cfg.redactor != nil {
+		redacted := *event
+		redacted.Message = cfg.redactor(event.Message)
+		if event.Annotations != nil {
+			redactedAnnotations := make(map[string]string, len(event.Annotations))
+			for k, v := range event.Annotations {
+				redactedAnnotations[k] = cfg.redactor(v)
+			}
+			redacted.Annotations = redactedAnnotations
+		}
+		event = &redacted
+	}
+	if cfg.compressMessage {
+		compressed, err := compressMessage(event.Message)
+		if err != nil {
+			return nil, err
+		}
+		withCompression := *event
+		withCompression.Message = compressed
+		withCompression.Annotations = cloneAndSetAnnotation(event.Annotations, CompressedMessageAnnotation, "true")
+		event = &withCompression
+	}
+	if cfg.limiter != nil {
+		if cfg.dropOnExceeded {
+			if !cfg.limiter.Allow() {
+				DroppedEventCreatesMetric.Inc()
+				return nil, nil
+			}
+		} else if err := cfg.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.circuitBreaker != nil && !cfg.circuitBreaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+	var result *v1.Event
+	var err error
+	if cfg.wantsRequestOptions() {
+		result, err = createWithEventNamespaceAndRequestOptions(e, event, cfg)
+	} else {
+		result, err = e.CreateWithEventNamespace(event)
+	}
+	if cfg.circuitBreaker != nil {
+		cfg.circuitBreaker.recordResult(err)
+	}
+	if err == nil {
+		cfg.recentBuffer.record(result)
+		if cfg.exemplarRecorder != nil && result.Type == v1.EventTypeWarning {
+			cfg.exemplarRecorder(result)
+		}
+	}
+	return result, err
+}
+
+// relatedObjectAnnotationValue renders ref into the stable "kind/namespace/name"
+// form stored in RelatedObjectAnnotation.
+func relatedObjectAnnotationValue(ref *v1.ObjectReference) string {
+	return ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+}
+
+// cloneAndSetAnnotation returns a copy of annotations with key set to value,
+// without mutating the caller's map.
+func cloneAndSetAnnotation(annotations map[string]string, key, value string) map[string]string {
+	out := make(map[string]string, len(annotations)+1)
+	for k, v := range annotations {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithMessageTruncationShortensOverlongMessage(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		Message:    strings.Repeat("a", MaxEventMessageBytes+100),
+	}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithMessageTruncation()); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if len(sent.Message) != MaxEventMessageBytes {
+		t.Errorf("len(sent.Message) = %d, want %d", len(sent.Message), MaxEventMessageBytes)
+	}
+	if !strings.HasSuffix(sent.Message, "...") {
+		t.Errorf("sent.Message = %q, want it to end with an ellipsis", sent.Message)
+	}
+}
+
+func TestWithRateLimiterDropsAndCountsWhenExceeded(t *testing.T) {
+	metric := &countingMetric{}
+	old := DroppedEventCreatesMetric
+	DroppedEventCreatesMetric = metric
+	defer func() { DroppedEventCreatesMetric = old }()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for a dropped create")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	limiter := rate.NewLimiter(0, 0)
+
+	result, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithRateLimiter(limiter, true))
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if result != nil {
+		t.Errorf("result = %v, want nil for a dropped create", result)
+	}
+	if metric.Count() != 1 {
+		t.Errorf("DroppedEventCreatesMetric count = %d, want 1", metric.Count())
+	}
+}
+
+func TestWithOwnerReferenceRejectsEventThatAlreadyHasOne(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when the owner reference conflicts")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "e1",
+			Namespace:       "ns",
+			OwnerReferences: []metav1.OwnerReference{{Name: "existing"}},
+		},
+	}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithOwnerReference(metav1.OwnerReference{Name: "new-owner"}))
+	if err == nil {
+		t.Fatalf("expected an error for an event that already has owner references")
+	}
+}
+
+func TestWithRelatedAndCorrelationIDStampAnnotations(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	related := &v1.ObjectReference{Kind: "PersistentVolumeClaim", Namespace: "ns", Name: "data"}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithRelated(related), WithCorrelationID("trace-1"))
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if sent.Annotations[RelatedObjectAnnotation] == "" {
+		t.Errorf("missing %s annotation: %v", RelatedObjectAnnotation, sent.Annotations)
+	}
+	if sent.Annotations[CorrelationIDAnnotation] != "trace-1" {
+		t.Errorf("%s = %q, want trace-1", CorrelationIDAnnotation, sent.Annotations[CorrelationIDAnnotation])
+	}
+}
+
+func TestWithValidationFailsBeforeContactingServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when validation fails")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithValidation()); err == nil {
+		t.Fatalf("expected a validation error for an event missing required fields")
+	}
+}
+
+func TestWithExemplarRecorderFiresOnlyForWarningEvents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Type: v1.EventTypeWarning})
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	var recorded *v1.Event
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Type: v1.EventTypeWarning}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithExemplarRecorder(func(e *v1.Event) { recorded = e }))
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if recorded == nil {
+		t.Fatalf("exemplar recorder was not called for a Warning event")
+	}
+}
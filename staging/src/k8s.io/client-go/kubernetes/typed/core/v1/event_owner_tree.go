@@ -0,0 +1,65 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// OwnerTreeNode is one level of the owner chain built by SearchOwnerTree: the
+// object at this level, the events found about it, and the node for its own
+// owner (nil once the chain's root is reached or resolveOwner finds none).
+type OwnerTreeNode struct {
+	Object *v1.ObjectReference
+	Events []v1.Event
+	Owner  *OwnerTreeNode
+}
+
+// SearchOwnerTree searches for events about objOrRef, then walks the owner
+// chain via resolveOwner (e.g. Deployment -> ReplicaSet -> Pod, read in
+// reverse: Pod -> ReplicaSet -> Deployment) attaching objOrRef's events at
+// the leaf and one empty node per ancestor, for a "blast radius" debug view.
+// Owner resolution is caller-provided so this package stays decoupled from
+// other resource types; resolveOwner returning (nil, nil) ends the walk.
+func SearchOwnerTree(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, resolveOwner func(*v1.ObjectReference) (*v1.ObjectReference, error)) (*OwnerTreeNode, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	root, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	leaf := &OwnerTreeNode{Object: root, Events: list.Items}
+
+	current := leaf
+	for {
+		owner, err := resolveOwner(current.Object)
+		if err != nil {
+			return nil, err
+		}
+		if owner == nil {
+			break
+		}
+		next := &OwnerTreeNode{Object: owner}
+		current.Owner = next
+		current = next
+	}
+	return leaf, nil
+}
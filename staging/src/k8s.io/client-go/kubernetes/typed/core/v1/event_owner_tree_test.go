@@ -0,0 +1,94 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchOwnerTreeWalksOwnerChain(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"},"reason":"Killing"}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	replicaSet := &v1.ObjectReference{Kind: "ReplicaSet", Name: "rs1", Namespace: "ns"}
+	deployment := &v1.ObjectReference{Kind: "Deployment", Name: "d1", Namespace: "ns"}
+	resolveOwner := func(obj *v1.ObjectReference) (*v1.ObjectReference, error) {
+		switch obj.Kind {
+		case "Pod":
+			return replicaSet, nil
+		case "ReplicaSet":
+			return deployment, nil
+		default:
+			return nil, nil
+		}
+	}
+
+	leaf, err := SearchOwnerTree(e, scheme, pod, resolveOwner)
+	if err != nil {
+		t.Fatalf("SearchOwnerTree: %v", err)
+	}
+	if leaf.Object.Kind != "Pod" || len(leaf.Events) != 1 {
+		t.Fatalf("leaf = %+v, want a Pod node with 1 event", leaf)
+	}
+	if leaf.Owner == nil || leaf.Owner.Object.Kind != "ReplicaSet" {
+		t.Fatalf("leaf.Owner = %+v, want a ReplicaSet node", leaf.Owner)
+	}
+	if leaf.Owner.Owner == nil || leaf.Owner.Owner.Object.Kind != "Deployment" {
+		t.Fatalf("leaf.Owner.Owner = %+v, want a Deployment node", leaf.Owner.Owner)
+	}
+	if leaf.Owner.Owner.Owner != nil {
+		t.Fatalf("leaf.Owner.Owner.Owner = %+v, want nil (chain should end at Deployment)", leaf.Owner.Owner.Owner)
+	}
+}
+
+func TestSearchOwnerTreePropagatesResolveOwnerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	wantErr := errors.New("boom")
+
+	_, err := SearchOwnerTree(e, scheme, pod, func(*v1.ObjectReference) (*v1.ObjectReference, error) {
+		return nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("SearchOwnerTree() error = %v, want %v", err, wantErr)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// errPagerExhausted is returned by Pager.Next once a prior call has already
+// reported no more pages remain.
+var errPagerExhausted = errors.New("event pager is exhausted")
+
+// Pager fetches events about a single object one page at a time, for callers
+// that want explicit control over paging (e.g. infinite-scroll UIs) rather
+// than an auto-paging helper that reads everything. A Pager is not safe for
+// concurrent use.
+type Pager struct {
+	e             EventInterface
+	fieldSelector string
+	pageSize      int64
+	continueTok   string
+	exhausted     bool
+}
+
+// NewSearchPager returns a Pager over events about objOrRef, fetching
+// pageSize items per call to Next. It encapsulates the involved-object field
+// selector and the List Continue token so callers don't manage either.
+func NewSearchPager(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, pageSize int) (*Pager, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	return &Pager{
+		e:             e,
+		fieldSelector: involvedObjectFieldSelector(e, objRef),
+		pageSize:      int64(pageSize),
+	}, nil
+}
+
+// Next fetches the next page of events. The returned bool reports whether
+// additional pages remain after this one. Calling Next again after it has
+// already returned false returns errPagerExhausted.
+func (p *Pager) Next(ctx context.Context) (*v1.EventList, bool, error) {
+	if p.exhausted {
+		return nil, false, errPagerExhausted
+	}
+	list, err := p.e.List(ctx, metav1.ListOptions{
+		FieldSelector: p.fieldSelector,
+		Limit:         p.pageSize,
+		Continue:      p.continueTok,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	p.continueTok = list.Continue
+	if p.continueTok == "" {
+		p.exhausted = true
+	}
+	return list, !p.exhausted, nil
+}
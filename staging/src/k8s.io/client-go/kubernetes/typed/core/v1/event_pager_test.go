@@ -0,0 +1,74 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPagerWalksThroughAllPages(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if requests == 1 {
+			w.Write([]byte(`{"metadata":{"continue":"page2"},"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e2","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	pager, err := NewSearchPager(e, scheme, pod, 1)
+	if err != nil {
+		t.Fatalf("NewSearchPager: %v", err)
+	}
+
+	page1, more, err := pager.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next (page 1): %v", err)
+	}
+	if !more || len(page1.Items) != 1 || page1.Items[0].Name != "e1" {
+		t.Fatalf("page1 = %v more=%v, want [e1] more=true", page1.Items, more)
+	}
+
+	page2, more, err := pager.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next (page 2): %v", err)
+	}
+	if more || len(page2.Items) != 1 || page2.Items[0].Name != "e2" {
+		t.Fatalf("page2 = %v more=%v, want [e2] more=false", page2.Items, more)
+	}
+
+	if _, _, err := pager.Next(context.Background()); !errors.Is(err, errPagerExhausted) {
+		t.Fatalf("Next after exhaustion: err = %v, want errPagerExhausted", err)
+	}
+}
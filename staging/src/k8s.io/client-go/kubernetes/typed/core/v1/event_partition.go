@@ -0,0 +1,78 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// WithPartitionLabel sets key=value as a label on the created event, so
+// namespace-wide summaries can be cheaply sharded across teams sharing a
+// namespace via SummarizeNamespace's partition filter. It fails the create
+// if key is not a legal label key, and never overwrites a label the caller
+// already set under the same key.
+func WithPartitionLabel(key, value string) CreateOption {
+	return func(c *createConfig) {
+		c.partitionLabelKey = key
+		c.partitionLabelValue = value
+	}
+}
+
+// applyPartitionLabel validates key and returns event with key=value merged
+// into its labels, without overwriting a label the caller already set.
+func applyPartitionLabel(event *v1.Event, key, value string) (*v1.Event, error) {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid partition label key %q: %s", key, strings.Join(errs, "; "))
+	}
+	if _, exists := event.Labels[key]; exists {
+		return nil, fmt.Errorf("event %s/%s already has a %q label", event.Namespace, event.Name, key)
+	}
+	withLabel := *event
+	withLabel.Labels = make(map[string]string, len(event.Labels)+1)
+	for k, v := range event.Labels {
+		withLabel.Labels[k] = v
+	}
+	withLabel.Labels[key] = value
+	return &withLabel, nil
+}
+
+// SummarizeNamespace lists events in e's namespace, optionally restricted to
+// those carrying partitionKey=partitionValue (see WithPartitionLabel), and
+// returns counts by reason. Pass an empty partitionKey to summarize the
+// whole namespace.
+func SummarizeNamespace(ctx context.Context, e EventInterface, partitionKey, partitionValue string) (map[string]int, error) {
+	opts := metav1.ListOptions{}
+	if partitionKey != "" {
+		opts.LabelSelector = labels.Set{partitionKey: partitionValue}.AsSelector().String()
+	}
+	list, err := e.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	summary := map[string]int{}
+	for i := range list.Items {
+		summary[list.Items[i].Reason]++
+	}
+	return summary, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithPartitionLabelAddsLabelWithoutOverwriting(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithPartitionLabel("team", "infra")); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if sent.Labels["team"] != "infra" {
+		t.Errorf("labels = %v, want team=infra", sent.Labels)
+	}
+}
+
+func TestWithPartitionLabelRejectsConflictingLabel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when the label already exists")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns", Labels: map[string]string{"team": "existing"}},
+	}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithPartitionLabel("team", "infra")); err == nil {
+		t.Fatalf("expected an error for a conflicting label")
+	}
+}
+
+func TestSummarizeNamespaceCountsByReason(t *testing.T) {
+	var gotLabelSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLabelSelector = r.URL.Query().Get("labelSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"BackOff"},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"BackOff"},
+			{"metadata":{"name":"e3","namespace":"ns"},"reason":"Pulled"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	summary, err := SummarizeNamespace(context.Background(), e, "team", "infra")
+	if err != nil {
+		t.Fatalf("SummarizeNamespace: %v", err)
+	}
+	if summary["BackOff"] != 2 || summary["Pulled"] != 1 {
+		t.Errorf("summary = %v, want BackOff:2 Pulled:1", summary)
+	}
+	if gotLabelSelector != "team=infra" {
+		t.Errorf("labelSelector = %q, want team=infra", gotLabelSelector)
+	}
+}
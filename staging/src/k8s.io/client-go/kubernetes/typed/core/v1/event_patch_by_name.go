@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PatchWithEventNamespaceByName patches the event named name in namespace,
+// the same way PatchWithEventNamespaceOfType does, but without requiring a
+// full *v1.Event just to read its Namespace and Name -- useful when a caller
+// (e.g. a webhook handler) only has the name, namespace and raw patch bytes.
+// PatchWithEventNamespaceOfType delegates to this. An empty name is
+// rejected before any request is made.
+func PatchWithEventNamespaceByName(ctx context.Context, e EventInterface, namespace, name string, pt types.PatchType, data []byte) (result *v1.Event, err error) {
+	defer func(start time.Time) { observeWrite("patch", start, err) }(time.Now())
+	if name == "" {
+		return nil, fmt.Errorf("event name is required")
+	}
+	impl, ok := e.(*events)
+	if !ok {
+		return e.Patch(ctx, name, pt, data, metav1.PatchOptions{})
+	}
+	if impl.ns != "" && namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't patch an event with namespace '%v' in namespace '%v'", namespace, impl.ns))
+	}
+	result = &v1.Event{}
+	err = impl.client.Patch(pt).
+		NamespaceIfScoped(namespace, len(namespace) > 0).
+		Resource(eventResourceName()).
+		Name(name).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
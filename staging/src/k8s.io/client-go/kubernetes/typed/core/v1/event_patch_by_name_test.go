@@ -0,0 +1,79 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPatchWithEventNamespaceByNameRejectsEmptyNameWithoutARequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when name is empty")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	_, err := PatchWithEventNamespaceByName(context.Background(), e, "ns", "", types.StrategicMergePatchType, []byte(`{}`))
+	if err == nil {
+		t.Fatalf("expected an error for an empty name")
+	}
+}
+
+func TestPatchWithEventNamespaceByNameIssuesPatch(t *testing.T) {
+	var gotMethod string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	result, err := PatchWithEventNamespaceByName(context.Background(), e, "ns", "e1", types.StrategicMergePatchType, []byte(`{}`))
+	if err != nil {
+		t.Fatalf("PatchWithEventNamespaceByName: %v", err)
+	}
+	if gotMethod != http.MethodPatch {
+		t.Fatalf("method = %q, want PATCH", gotMethod)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+}
+
+func TestPatchWithEventNamespaceDelegatesToByName(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := e.PatchWithEventNamespace(event, []byte(`{}`)); err != nil {
+		t.Fatalf("PatchWithEventNamespace: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// CreatePatchBytes builds a strategic merge patch describing the change
+// from original to modified, ready to pass as the data argument to
+// PatchWithEventNamespace (which defaults to types.StrategicMergePatchType)
+// or PatchWithEventNamespaceOfType. Two identical events produce the patch
+// "{}", and a change to a single field (e.g. Series.Count) produces a patch
+// containing only that field, not a full copy of modified.
+func CreatePatchBytes(original, modified *v1.Event) ([]byte, error) {
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedJSON, err := json.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+	return strategicpatch.CreateTwoWayMergePatch(originalJSON, modifiedJSON, &v1.Event{})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreatePatchBytesNoDiffYieldsEmptyObject(t *testing.T) {
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Reason: "Started"}
+	patch, err := CreatePatchBytes(event, event.DeepCopy())
+	if err != nil {
+		t.Fatalf("CreatePatchBytes: %v", err)
+	}
+	if strings.TrimSpace(string(patch)) != "{}" {
+		t.Fatalf("patch = %s, want {}", patch)
+	}
+}
+
+func TestCreatePatchBytesOnlyIncludesChangedField(t *testing.T) {
+	original := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		Reason:     "Started",
+		Series:     &v1.EventSeries{Count: 1},
+	}
+	modified := original.DeepCopy()
+	modified.Series.Count = 2
+
+	patch, err := CreatePatchBytes(original, modified)
+	if err != nil {
+		t.Fatalf("CreatePatchBytes: %v", err)
+	}
+	if !strings.Contains(string(patch), `"count":2`) {
+		t.Fatalf("patch = %s, want it to mention the new count", patch)
+	}
+	if strings.Contains(string(patch), `"reason"`) {
+		t.Fatalf("patch = %s, want it to omit the unchanged reason", patch)
+	}
+}
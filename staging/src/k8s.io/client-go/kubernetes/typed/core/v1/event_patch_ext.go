@@ -0,0 +1,90 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ImmutableFieldError reports that a patch attempted to mutate one or more
+// fields that PatchWithEventNamespaceChecked treats as immutable once an
+// event exists.
+type ImmutableFieldError struct {
+	Paths []string
+}
+
+func (e *ImmutableFieldError) Error() string {
+	return fmt.Sprintf("patch may not mutate immutable event field(s): %s", strings.Join(e.Paths, ", "))
+}
+
+// immutableEventFields are the JSON field names PatchWithEventNamespaceChecked
+// refuses to let a patch touch, because changing them on an existing event
+// would silently change what the event is about.
+var immutableEventFields = []string{"involvedObject", "reason"}
+
+// PatchWithEventNamespaceChecked is an opt-in, safety-conscious variant of
+// PatchWithEventNamespace: it decodes data first and rejects the patch with
+// an *ImmutableFieldError if it would mutate involvedObject or reason on an
+// existing series. The unchecked PatchWithEventNamespace is left as-is for
+// callers that already know their patches are safe and want to avoid the
+// extra decode.
+func PatchWithEventNamespaceChecked(e EventInterface, incompleteEvent *v1.Event, data []byte) (*v1.Event, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var offending []string
+	for _, name := range immutableEventFields {
+		if _, present := fields[name]; present {
+			offending = append(offending, name)
+		}
+	}
+	if len(offending) > 0 {
+		return nil, &ImmutableFieldError{Paths: offending}
+	}
+	return e.PatchWithEventNamespace(incompleteEvent, data)
+}
+
+// PatchWithEventNamespaceIfVersion patches the named event using pt and data,
+// but only if the event's current resourceVersion still matches
+// resourceVersion, giving compare-and-swap semantics for racy aggregation
+// paths. On a mismatch the apiserver rejects the patch with a conflict
+// error (apierrors.IsConflict), which callers can use to retry.
+func PatchWithEventNamespaceIfVersion(ctx context.Context, e EventInterface, event *v1.Event, resourceVersion string, pt types.PatchType, data []byte) (*v1.Event, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("decoding patch: %w", err)
+	}
+	metadata, _ := fields["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	metadata["resourceVersion"] = resourceVersion
+	fields["metadata"] = metadata
+	versioned, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return e.Patch(ctx, event.Name, pt, versioned, metav1.PatchOptions{})
+}
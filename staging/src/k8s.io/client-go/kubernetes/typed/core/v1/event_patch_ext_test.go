@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPatchWithEventNamespaceCheckedRejectsImmutableFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted when the patch touches immutable fields")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	_, err := PatchWithEventNamespaceChecked(e, event, []byte(`{"reason":"Changed"}`))
+	var immutableErr *ImmutableFieldError
+	if !errors.As(err, &immutableErr) {
+		t.Fatalf("err = %v, want *ImmutableFieldError", err)
+	}
+	if len(immutableErr.Paths) != 1 || immutableErr.Paths[0] != "reason" {
+		t.Errorf("immutableErr.Paths = %v, want [reason]", immutableErr.Paths)
+	}
+}
+
+func TestPatchWithEventNamespaceCheckedAllowsSafeFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"},"count":2}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	result, err := PatchWithEventNamespaceChecked(e, event, []byte(`{"count":2}`))
+	if err != nil {
+		t.Fatalf("PatchWithEventNamespaceChecked: %v", err)
+	}
+	if result.Count != 2 {
+		t.Errorf("result.Count = %d, want 2", result.Count)
+	}
+}
+
+func TestPatchWithEventNamespaceIfVersionSendsResourceVersion(t *testing.T) {
+	var gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, r.ContentLength)
+		r.Body.Read(buf)
+		gotBody = string(buf)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns","resourceVersion":"2"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	_, err := PatchWithEventNamespaceIfVersion(context.Background(), e, event, "1", types.MergePatchType, []byte(`{"count":2}`))
+	if err != nil {
+		t.Fatalf("PatchWithEventNamespaceIfVersion: %v", err)
+	}
+	if gotBody == "" {
+		t.Fatalf("expected a request body")
+	}
+}
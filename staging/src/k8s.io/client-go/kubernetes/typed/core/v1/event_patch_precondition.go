@@ -0,0 +1,49 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ErrPreconditionFailed is returned by PatchWithPrecondition when
+// precondition rejects the event's current state.
+var ErrPreconditionFailed = errors.New("event precondition failed, patch not applied")
+
+// PatchWithPrecondition GETs incompleteEvent's current state, and only
+// applies the patch if precondition returns true for it; otherwise it
+// returns ErrPreconditionFailed without sending the patch. This is racy
+// between the GET and the PatchWithEventNamespace call unless data itself
+// carries a resourceVersion precondition (e.g. as part of a JSON merge
+// patch or a resourceVersion-qualified strategic merge patch) — the GET
+// only tells the caller whether attempting the patch is still worthwhile,
+// it doesn't make the patch atomic with the read.
+func PatchWithPrecondition(ctx context.Context, e EventInterface, incompleteEvent *v1.Event, precondition func(*v1.Event) bool, pt types.PatchType, data []byte) (*v1.Event, error) {
+	current, err := e.Get(ctx, incompleteEvent.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !precondition(current) {
+		return nil, ErrPreconditionFailed
+	}
+	return e.Patch(ctx, incompleteEvent.Name, pt, data, metav1.PatchOptions{})
+}
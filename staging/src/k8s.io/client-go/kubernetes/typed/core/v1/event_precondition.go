@@ -0,0 +1,52 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CreateIfAbsent searches for an event matching event (per SameLogicalEvent)
+// within the last `within` duration and, if none is found, creates event. It
+// returns the resulting event and whether a create actually happened. This is
+// a lightweight "don't spam" guarantee per time window, distinct from the
+// broadcaster's own aggregation, for callers that want a hard precondition
+// rather than a best-effort count bump.
+func CreateIfAbsent(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, event *v1.Event, within time.Duration) (*v1.Event, bool, error) {
+	existing, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, false, err
+	}
+	now := time.Now()
+	for i := range existing.Items {
+		candidate := &existing.Items[i]
+		if now.Sub(candidate.LastTimestamp.Time) > within {
+			continue
+		}
+		if SameLogicalEvent(candidate, event) {
+			return candidate, false, nil
+		}
+	}
+	created, err := e.CreateWithEventNamespace(event)
+	if err != nil {
+		return nil, false, err
+	}
+	return created, true, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCreateIfAbsentReturnsExistingMatchWithoutCreating(t *testing.T) {
+	now := metav1.NewTime(time.Now())
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			t.Fatal("server should not receive a create when a matching event already exists")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"items":[{"metadata":{"name":"existing","namespace":"ns"},"reason":"BackOff","message":"m","type":"Warning","lastTimestamp":%q}]}`, now.Time.Format(time.RFC3339))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	event := &v1.Event{Reason: "BackOff", Message: "m", Type: "Warning"}
+
+	result, created, err := CreateIfAbsent(e, scheme, pod, event, time.Hour)
+	if err != nil {
+		t.Fatalf("CreateIfAbsent: %v", err)
+	}
+	if created {
+		t.Errorf("created = true, want false")
+	}
+	if result.Name != "existing" {
+		t.Errorf("result.Name = %q, want existing", result.Name)
+	}
+}
+
+func TestCreateIfAbsentCreatesWhenNoMatchWithinWindow(t *testing.T) {
+	stale := metav1.NewTime(time.Now().Add(-time.Hour))
+	var created bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"metadata":{"name":"new-event","namespace":"ns"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"items":[{"metadata":{"name":"old","namespace":"ns"},"reason":"BackOff","message":"m","type":"Warning","lastTimestamp":%q}]}`, stale.Time.Format(time.RFC3339))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "new-event", Namespace: "ns"}, Reason: "BackOff", Message: "m", Type: "Warning"}
+
+	result, didCreate, err := CreateIfAbsent(e, scheme, pod, event, time.Minute)
+	if err != nil {
+		t.Fatalf("CreateIfAbsent: %v", err)
+	}
+	if !didCreate {
+		t.Errorf("created = false, want true")
+	}
+	if !created {
+		t.Errorf("expected the server to receive a create request")
+	}
+	if result.Name != "new-event" {
+		t.Errorf("result.Name = %q, want new-event", result.Name)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// PriorityHintHeader is the request header WithPriorityHint sets to suggest
+// which API Priority and Fairness flow schema should match an event create.
+// It only takes effect if the server has a flow schema configured to match
+// on it; with no matching flow schema, APF falls through to its normal
+// user/verb/resource matching and the header is ignored.
+const PriorityHintHeader = "X-Kubernetes-Priority-Hint"
+
+// WithPriorityHint sets PriorityHintHeader to level on the create request,
+// so clusters with a dedicated low-priority flow schema for events can keep
+// an event storm from starving higher-priority reconcile traffic. The
+// default, with this option absent, is no hint: APF matches the request
+// exactly as it would any other write from this client.
+func WithPriorityHint(level string) CreateOption {
+	return func(c *createConfig) {
+		c.priorityHint = level
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSearchProtobufNegotiatesProtobufAccept(t *testing.T) {
+	var gotAccept string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	list, err := SearchProtobuf(context.Background(), e, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("SearchProtobuf: %v", err)
+	}
+	if gotAccept != protobufAcceptHeader {
+		t.Errorf("Accept = %q, want %q", gotAccept, protobufAcceptHeader)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Errorf("list.Items = %v, want [e1]", list.Items)
+	}
+}
+
+func TestSearchProtobufFallsBackOnServerError(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Accept") == protobufAcceptHeader {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	list, err := SearchProtobuf(context.Background(), e, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("SearchProtobuf: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("requests = %d, want 2 (protobuf attempt + JSON fallback)", requests)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Errorf("list.Items = %v, want [e1]", list.Items)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+)
+
+// RateLimitedEventClient wraps an EventInterface to throttle
+// CreateWithEventNamespace (and its Context variant) to at most Limiter's
+// rate, independent of whatever rate limiting the underlying REST client
+// itself applies. Unlike WithRateLimiter, which is a per-call CreateOption
+// that every call site has to remember to pass, wrapping a client once with
+// NewRateLimitedEventClient makes every create through it limited without
+// further opt-in. All other EventInterface methods, including Update and
+// Patch, pass through unchanged.
+type RateLimitedEventClient struct {
+	EventInterface
+	Limiter *rate.Limiter
+}
+
+// NewRateLimitedEventClient returns an EventInterface that behaves like e,
+// except that CreateWithEventNamespace blocks until Limiter, built from qps
+// and burst the same way rate.NewLimiter expects, admits the call -- or
+// returns ctx.Err() promptly if the caller's context is done first.
+func NewRateLimitedEventClient(e EventInterface, qps float64, burst int) *RateLimitedEventClient {
+	return &RateLimitedEventClient{
+		EventInterface: e,
+		Limiter:        rate.NewLimiter(rate.Limit(qps), burst),
+	}
+}
+
+// CreateWithEventNamespace is CreateWithEventNamespaceContext with
+// context.TODO(), matching the EventExpansion convention that the
+// non-Context method is a thin wrapper around its Context counterpart.
+func (r *RateLimitedEventClient) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	return r.CreateWithEventNamespaceContext(context.TODO(), event)
+}
+
+// CreateWithEventNamespaceContext waits for r.Limiter to admit the call
+// before delegating to the wrapped EventInterface; a cancelled or timed-out
+// ctx short-circuits the wait and returns ctx.Err() without creating
+// anything.
+func (r *RateLimitedEventClient) CreateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (*v1.Event, error) {
+	if err := r.Limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.EventInterface.CreateWithEventNamespaceContext(ctx, event)
+}
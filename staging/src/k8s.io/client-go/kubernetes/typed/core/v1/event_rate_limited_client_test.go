@@ -0,0 +1,75 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRateLimitedEventClientBlocksBeyondBurst(t *testing.T) {
+	var creates int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&creates, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	limited := NewRateLimitedEventClient(e, 1, 1)
+
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := limited.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("first create: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limited.CreateWithEventNamespaceContext(ctx, event); err == nil {
+		t.Fatalf("expected the second create to be blocked by the rate limiter")
+	}
+	if atomic.LoadInt32(&creates) != 1 {
+		t.Fatalf("creates = %d, want 1 (the second should never have reached the server)", creates)
+	}
+}
+
+func TestRateLimitedEventClientShortCircuitsOnCancelledContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("server should not have been contacted")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	limited := NewRateLimitedEventClient(e, 1, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := limited.CreateWithEventNamespaceContext(ctx, event); err != context.Canceled {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "fmt"
+
+// MaxReasonsPerObjectError is returned by CreateWithEventNamespaceOptions
+// when WithMaxReasonsPerObject is set and event would introduce a new
+// distinct reason beyond the configured cap.
+type MaxReasonsPerObjectError struct {
+	Max int
+}
+
+func (e *MaxReasonsPerObjectError) Error() string {
+	return fmt.Sprintf("object already has the maximum of %d distinct event reasons", e.Max)
+}
+
+// WithMaxReasonsPerObject guards against a known cardinality-explosion bug
+// where a buggy controller creates an unbounded number of distinct reasons
+// about one object: once event.InvolvedObject already has n distinct
+// reasons (via DistinctReasonsForObject) and event.Reason isn't one of
+// them, the create is refused with a *MaxReasonsPerObjectError instead of
+// going to the server. This is opt-in and costs one extra read (a Search)
+// per create when enabled.
+func WithMaxReasonsPerObject(n int) CreateOption {
+	return func(c *createConfig) {
+		c.maxReasonsPerObject = n
+	}
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
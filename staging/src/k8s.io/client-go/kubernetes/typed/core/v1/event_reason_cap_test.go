@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithMaxReasonsPerObjectRejectsNewReasonBeyondCap(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			t.Fatal("server should not receive a create once the reason cap is exceeded")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"BackOff"},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"Pulled"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e3", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "Unhealthy",
+	}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithMaxReasonsPerObject(2))
+	var capErr *MaxReasonsPerObjectError
+	if !errors.As(err, &capErr) {
+		t.Fatalf("err = %v, want *MaxReasonsPerObjectError", err)
+	}
+	if capErr.Max != 2 {
+		t.Errorf("capErr.Max = %d, want 2", capErr.Max)
+	}
+}
+
+func TestWithMaxReasonsPerObjectAllowsExistingReason(t *testing.T) {
+	var created bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns"},"reason":"BackOff"},
+			{"metadata":{"name":"e2","namespace":"ns"},"reason":"Pulled"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e3", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"},
+		Reason:         "BackOff",
+	}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithMaxReasonsPerObject(2))
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if !created {
+		t.Errorf("expected the server to receive a create request for an already-seen reason")
+	}
+}
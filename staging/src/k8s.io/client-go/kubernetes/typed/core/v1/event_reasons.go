@@ -0,0 +1,72 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// reasonRegistry tracks the reasons controllers have opted into via
+// RegisterReason, for teams that want a controlled vocabulary instead of the
+// default free-form Reason field. It is empty, and therefore non-enforcing,
+// until a caller registers something.
+var reasonRegistry = struct {
+	mu      sync.RWMutex
+	reasons map[string]bool
+}{reasons: map[string]bool{}}
+
+// RegisterReason adds reason to the set of known reasons. It is typically
+// called from init() by controllers that want WithKnownReasonsOnly to accept
+// their events.
+func RegisterReason(reason string) {
+	reasonRegistry.mu.Lock()
+	defer reasonRegistry.mu.Unlock()
+	reasonRegistry.reasons[reason] = true
+}
+
+// RegisteredReasons returns the sorted list of reasons registered so far, for
+// documentation generation.
+func RegisteredReasons() []string {
+	reasonRegistry.mu.RLock()
+	defer reasonRegistry.mu.RUnlock()
+	out := make([]string, 0, len(reasonRegistry.reasons))
+	for reason := range reasonRegistry.reasons {
+		out = append(out, reason)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// WithKnownReasonsOnly rejects the create if the event's Reason hasn't been
+// registered via RegisterReason. This is opt-in governance: by default
+// (this option absent), any reason string is accepted, exactly as today.
+func WithKnownReasonsOnly() CreateOption {
+	return func(c *createConfig) {
+		c.requireKnownReason = true
+	}
+}
+
+func checkKnownReason(reason string) error {
+	reasonRegistry.mu.RLock()
+	defer reasonRegistry.mu.RUnlock()
+	if !reasonRegistry.reasons[reason] {
+		return fmt.Errorf("event reason %q is not registered; call RegisterReason first", reason)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRegisterReasonAddsToRegisteredReasons(t *testing.T) {
+	RegisterReason("TestRegisterReasonAddsToRegisteredReasons-Known")
+
+	reasons := RegisteredReasons()
+	idx := sort.SearchStrings(reasons, "TestRegisterReasonAddsToRegisteredReasons-Known")
+	if idx == len(reasons) || reasons[idx] != "TestRegisterReasonAddsToRegisteredReasons-Known" {
+		t.Fatalf("RegisteredReasons() = %v, want it to contain the just-registered reason", reasons)
+	}
+}
+
+func TestWithKnownReasonsOnlyRejectsUnregisteredReason(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for an unregistered reason")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Reason: "TestWithKnownReasonsOnlyRejectsUnregisteredReason-Unknown"}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithKnownReasonsOnly())
+	if err == nil {
+		t.Fatalf("expected an error for an unregistered reason")
+	}
+}
+
+func TestWithKnownReasonsOnlyAllowsRegisteredReason(t *testing.T) {
+	RegisterReason("TestWithKnownReasonsOnlyAllowsRegisteredReason-Known")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, Reason: "TestWithKnownReasonsOnlyAllowsRegisteredReason-Known"}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithKnownReasonsOnly()); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+}
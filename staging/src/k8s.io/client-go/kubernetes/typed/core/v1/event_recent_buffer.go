@@ -0,0 +1,83 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// RecentBuffer holds the last N events CreateWithEventNamespaceOptions has
+// created through it, for a /debugz-style endpoint to expose what a
+// controller has been emitting without querying the apiserver. It is
+// disabled (nil buffer) unless constructed with NewRecentBuffer, and is
+// safe for concurrent use.
+type RecentBuffer struct {
+	mu     sync.Mutex
+	events []*v1.Event
+	cap    int
+	next   int
+	full   bool
+}
+
+// NewRecentBuffer returns a RecentBuffer holding at most n events.
+func NewRecentBuffer(n int) *RecentBuffer {
+	return &RecentBuffer{events: make([]*v1.Event, n), cap: n}
+}
+
+// record appends event to the ring buffer, overwriting the oldest entry once
+// full. It is a no-op on a nil RecentBuffer, so WithRecentBuffer(nil) (or
+// the option simply being absent) costs nothing.
+func (b *RecentBuffer) record(event *v1.Event) {
+	if b == nil || b.cap == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events[b.next] = event
+	b.next = (b.next + 1) % b.cap
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// RecentEvents returns the buffered events, oldest first.
+func (b *RecentBuffer) RecentEvents() []*v1.Event {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		out := make([]*v1.Event, b.next)
+		copy(out, b.events[:b.next])
+		return out
+	}
+	out := make([]*v1.Event, b.cap)
+	copy(out, b.events[b.next:])
+	copy(out[b.cap-b.next:], b.events[:b.next])
+	return out
+}
+
+// WithRecentBuffer records every event CreateWithEventNamespaceOptions
+// successfully creates into buf, for later retrieval via buf.RecentEvents.
+func WithRecentBuffer(buf *RecentBuffer) CreateOption {
+	return func(c *createConfig) {
+		c.recentBuffer = buf
+	}
+}
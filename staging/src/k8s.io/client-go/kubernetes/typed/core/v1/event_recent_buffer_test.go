@@ -0,0 +1,73 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecentBufferWrapsAroundOnceFull(t *testing.T) {
+	buf := NewRecentBuffer(2)
+	buf.record(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}})
+	buf.record(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e2"}})
+	buf.record(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e3"}})
+
+	events := buf.RecentEvents()
+	if len(events) != 2 {
+		t.Fatalf("len(events) = %d, want 2", len(events))
+	}
+	if events[0].Name != "e2" || events[1].Name != "e3" {
+		t.Errorf("events = [%s %s], want [e2 e3]", events[0].Name, events[1].Name)
+	}
+}
+
+func TestRecentBufferNilIsANoOp(t *testing.T) {
+	var buf *RecentBuffer
+	buf.record(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}})
+
+	if events := buf.RecentEvents(); events != nil {
+		t.Errorf("RecentEvents() = %v, want nil for a nil buffer", events)
+	}
+}
+
+func TestWithRecentBufferRecordsSuccessfulCreates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"metadata":{"name":"e1","namespace":"ns"}}`)
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	buf := NewRecentBuffer(5)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithRecentBuffer(buf)); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	events := buf.RecentEvents()
+	if len(events) != 1 || events[0].Name != "e1" {
+		t.Errorf("RecentEvents() = %v, want [e1]", events)
+	}
+}
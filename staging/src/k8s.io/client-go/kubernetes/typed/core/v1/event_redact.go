@@ -0,0 +1,37 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "regexp"
+
+// defaultRedactionPatterns match common token/secret shapes that
+// controllers sometimes accidentally interpolate into event messages.
+var defaultRedactionPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)bearer\s+[a-z0-9._-]+`),
+	regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`\bey[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\b`),
+}
+
+const redactedPlaceholder = "[REDACTED]"
+
+// defaultRedactor is the redaction function behind WithDefaultRedaction.
+func defaultRedactor(s string) string {
+	for _, pattern := range defaultRedactionPatterns {
+		s = pattern.ReplaceAllString(s, redactedPlaceholder)
+	}
+	return s
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ReferenceRewriter rewrites an involvedObject reference before an event is
+// created, for federated setups where events emitted in a member cluster
+// need references normalized to the host cluster's naming.
+type ReferenceRewriter func(*v1.ObjectReference) *v1.ObjectReference
+
+// WithReferenceRewriter applies rewrite to event.InvolvedObject before
+// create. The default, with this option absent, is the identity rewrite:
+// the involved object is created exactly as the caller supplied it.
+// rewrite never sees, and can never mutate, the caller's own event object;
+// CreateWithEventNamespaceOptions operates on a copy.
+func WithReferenceRewriter(rewrite ReferenceRewriter) CreateOption {
+	return func(c *createConfig) {
+		c.referenceRewriter = rewrite
+	}
+}
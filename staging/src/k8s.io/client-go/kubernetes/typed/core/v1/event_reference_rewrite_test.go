@@ -0,0 +1,85 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithReferenceRewriterRewritesInvolvedObject(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "member", Name: "p1"},
+	}
+
+	rewrite := func(ref *v1.ObjectReference) *v1.ObjectReference {
+		out := ref.DeepCopy()
+		out.Namespace = "host"
+		return out
+	}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithReferenceRewriter(rewrite)); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if sent.InvolvedObject.Namespace != "host" {
+		t.Errorf("sent.InvolvedObject.Namespace = %q, want host", sent.InvolvedObject.Namespace)
+	}
+	if event.InvolvedObject.Namespace != "member" {
+		t.Errorf("caller's event.InvolvedObject.Namespace mutated to %q, want it left as member", event.InvolvedObject.Namespace)
+	}
+}
+
+func TestWithoutReferenceRewriterLeavesInvolvedObjectUnchanged(t *testing.T) {
+	var sent v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "member", Name: "p1"},
+	}
+
+	if _, err := CreateWithEventNamespaceOptions(context.Background(), e, event); err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if sent.InvolvedObject.Namespace != "member" {
+		t.Errorf("sent.InvolvedObject.Namespace = %q, want member (identity rewrite)", sent.InvolvedObject.Namespace)
+	}
+}
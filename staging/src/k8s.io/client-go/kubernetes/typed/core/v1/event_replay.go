@@ -0,0 +1,78 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Replay re-creates events in order, for restoring a captured event stream
+// into a test cluster or a new namespace. Each event is created with its
+// ResourceVersion and UID cleared, since those belong to the run that
+// originally recorded it and would make the create fail or be misleading.
+//
+// If eventsPerSecond is greater than zero, events are created at that fixed
+// rate. If it is zero, Replay instead preserves the relative timing of the
+// original recording, sleeping between creates for the gap between each
+// pair of events' FirstTimestamp. Either way, Replay returns as soon as ctx
+// is done.
+func Replay(ctx context.Context, e EventInterface, events []*v1.Event, eventsPerSecond float64) error {
+	var limiter *rate.Limiter
+	if eventsPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(eventsPerSecond), 1)
+	}
+	for i, event := range events {
+		if i > 0 {
+			if limiter != nil {
+				if err := limiter.Wait(ctx); err != nil {
+					return err
+				}
+			} else {
+				select {
+				case <-time.After(replayDelay(events[i-1], event)):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+		replayed := event.DeepCopy()
+		replayed.ResourceVersion = ""
+		replayed.UID = ""
+		if _, err := e.CreateWithEventNamespace(replayed); err != nil {
+			return fmt.Errorf("replaying event %d/%d (%s): %w", i+1, len(events), event.Name, err)
+		}
+	}
+	return nil
+}
+
+// replayDelay returns how long to wait after creating prev before creating
+// next, based on the gap between their original FirstTimestamps. A
+// nonsensical (negative, e.g. out-of-order input) gap is treated as no
+// delay rather than rejected, since Replay's job is best-effort timing, not
+// strict ordering validation.
+func replayDelay(prev, next *v1.Event) time.Duration {
+	gap := next.FirstTimestamp.Time.Sub(prev.FirstTimestamp.Time)
+	if gap < 0 {
+		return 0
+	}
+	return gap
+}
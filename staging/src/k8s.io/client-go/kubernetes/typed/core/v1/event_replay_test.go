@@ -0,0 +1,89 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReplayCreatesEventsInOrderWithIdentityCleared(t *testing.T) {
+	var mu sync.Mutex
+	var names []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		names = append(names, r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"replayed"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	base := time.Now()
+	events := []*v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns", ResourceVersion: "10", UID: "u1"}, FirstTimestamp: metav1.Time{Time: base}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e2", Namespace: "ns", ResourceVersion: "11", UID: "u2"}, FirstTimestamp: metav1.Time{Time: base}},
+	}
+	originalUID := events[0].UID
+
+	if err := Replay(context.Background(), e, events, 1000); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if len(names) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(names))
+	}
+	if events[0].UID != originalUID {
+		t.Errorf("Replay mutated the caller's input event's UID")
+	}
+}
+
+func TestReplayStopsWhenContextExpires(t *testing.T) {
+	var created int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		created++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"replayed"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	base := time.Now()
+	events := []*v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}, FirstTimestamp: metav1.Time{Time: base}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e2", Namespace: "ns"}, FirstTimestamp: metav1.Time{Time: base.Add(time.Hour)}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := Replay(ctx, e, events, 0)
+	if err == nil {
+		t.Fatalf("Replay() returned nil error, want context deadline exceeded")
+	}
+	if created != 1 {
+		t.Fatalf("created = %d events before ctx expired, want 1", created)
+	}
+}
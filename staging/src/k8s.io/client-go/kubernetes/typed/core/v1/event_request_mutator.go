@@ -0,0 +1,149 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// RequestMutator customizes a rest.Request built by a MutatingEventClient
+// before it is sent, e.g. to add an impersonation or tracing header. It is
+// the general-purpose escape hatch for request customization that doesn't
+// warrant its own CreateOption; misusing it (e.g. changing the verb or
+// resource) can break the request it's applied to.
+type RequestMutator func(*rest.Request)
+
+// MutatingEventClient wraps an EventInterface built on the standard *events
+// implementation, invoking Mutator -- if set -- on the rest.Request
+// underlying CreateWithEventNamespace, UpdateWithEventNamespace,
+// PatchWithEventNamespace and List (and therefore Search and every
+// Search-family helper in this package, which all resolve to a List call on
+// whatever EventInterface they're given) before it is sent. A nil Mutator is
+// a no-op: every request goes out unmodified, the same as if
+// MutatingEventClient weren't in the call chain at all. All other
+// EventInterface methods pass through to the wrapped client unchanged. If
+// the wrapped EventInterface isn't a *events (e.g. a fake used in tests),
+// the overridden methods also pass through unchanged, since there's no
+// rest.Request to mutate.
+type MutatingEventClient struct {
+	EventInterface
+	Mutator RequestMutator
+}
+
+// NewMutatingEventClient returns an EventInterface that behaves like e
+// except that, if mutator is non-nil, it is applied to the rest.Request
+// built by CreateWithEventNamespace, UpdateWithEventNamespace,
+// PatchWithEventNamespace and List/Search. A nil mutator is a valid,
+// explicitly supported no-op.
+func NewMutatingEventClient(e EventInterface, mutator RequestMutator) *MutatingEventClient {
+	return &MutatingEventClient{EventInterface: e, Mutator: mutator}
+}
+
+func (m *MutatingEventClient) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	impl, ok := m.EventInterface.(*events)
+	if !ok {
+		return m.EventInterface.CreateWithEventNamespace(event)
+	}
+	if impl.ns != "" && event.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't create an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns))
+	}
+	req := impl.client.Post().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource("events").
+		Body(event)
+	if m.Mutator != nil {
+		m.Mutator(req)
+	}
+	result := &v1.Event{}
+	err := req.Do(context.TODO()).Into(result)
+	return result, err
+}
+
+func (m *MutatingEventClient) UpdateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	impl, ok := m.EventInterface.(*events)
+	if !ok {
+		return m.EventInterface.UpdateWithEventNamespace(event)
+	}
+	req := impl.client.Put().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource("events").
+		Name(event.Name).
+		Body(event)
+	if m.Mutator != nil {
+		m.Mutator(req)
+	}
+	result := &v1.Event{}
+	err := req.Do(context.TODO()).Into(result)
+	return result, err
+}
+
+func (m *MutatingEventClient) PatchWithEventNamespace(incompleteEvent *v1.Event, data []byte) (*v1.Event, error) {
+	impl, ok := m.EventInterface.(*events)
+	if !ok {
+		return m.EventInterface.PatchWithEventNamespace(incompleteEvent, data)
+	}
+	if impl.ns != "" && incompleteEvent.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't patch an event with namespace '%v' in namespace '%v'", incompleteEvent.Namespace, impl.ns))
+	}
+	req := impl.client.Patch(types.StrategicMergePatchType).
+		NamespaceIfScoped(incompleteEvent.Namespace, len(incompleteEvent.Namespace) > 0).
+		Resource("events").
+		Name(incompleteEvent.Name).
+		Body(data)
+	if m.Mutator != nil {
+		m.Mutator(req)
+	}
+	result := &v1.Event{}
+	err := req.Do(context.TODO()).Into(result)
+	return result, err
+}
+
+// List behaves like (*events).List, except that, if set, Mutator is applied
+// to the underlying rest.Request before it is sent. Search and every
+// Search-family helper in this package (SearchWithOptions and everything
+// built on it) end up calling List on whatever EventInterface they're
+// given, so overriding it here is what makes Mutator apply to Search too,
+// per MutatingEventClient's doc comment.
+func (m *MutatingEventClient) List(ctx context.Context, opts metav1.ListOptions) (*v1.EventList, error) {
+	impl, ok := m.EventInterface.(*events)
+	if !ok {
+		return m.EventInterface.List(ctx, opts)
+	}
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	req := impl.client.Get().
+		Namespace(impl.ns).
+		Resource("events").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout)
+	if m.Mutator != nil {
+		m.Mutator(req)
+	}
+	result := &v1.EventList{}
+	err := req.Do(ctx).Into(result)
+	return result, err
+}
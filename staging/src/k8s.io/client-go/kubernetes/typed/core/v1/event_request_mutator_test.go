@@ -0,0 +1,115 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+func headerMutator(key, value string) RequestMutator {
+	return func(req *rest.Request) {
+		req.SetHeader(key, value)
+	}
+}
+
+func TestMutatingEventClientNilMutatorIsNoOp(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	m := NewMutatingEventClient(e, nil)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := m.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace with a nil Mutator panicked or failed: %v", err)
+	}
+	if _, err := m.UpdateWithEventNamespace(event); err != nil {
+		t.Fatalf("UpdateWithEventNamespace with a nil Mutator panicked or failed: %v", err)
+	}
+	if _, err := m.PatchWithEventNamespace(event, []byte(`{}`)); err != nil {
+		t.Fatalf("PatchWithEventNamespace with a nil Mutator panicked or failed: %v", err)
+	}
+	if _, err := m.List(context.Background(), metav1.ListOptions{}); err != nil {
+		t.Fatalf("List with a nil Mutator panicked or failed: %v", err)
+	}
+}
+
+func TestMutatingEventClientAppliesMutatorToCreateUpdatePatch(t *testing.T) {
+	var gotHeaders []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = append(gotHeaders, r.Header.Get("X-Test"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	m := NewMutatingEventClient(e, headerMutator("X-Test", "mutated"))
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	if _, err := m.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if _, err := m.UpdateWithEventNamespace(event); err != nil {
+		t.Fatalf("UpdateWithEventNamespace: %v", err)
+	}
+	if _, err := m.PatchWithEventNamespace(event, []byte(`{}`)); err != nil {
+		t.Fatalf("PatchWithEventNamespace: %v", err)
+	}
+	for i, got := range gotHeaders {
+		if got != "mutated" {
+			t.Fatalf("request %d: X-Test header = %q, want %q", i, got, "mutated")
+		}
+	}
+}
+
+func TestMutatingEventClientAppliesMutatorToSearch(t *testing.T) {
+	var gotHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Test")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	m := NewMutatingEventClient(e, headerMutator("X-Test", "mutated"))
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	if _, err := SearchWithOptions(context.Background(), m, scheme, pod, metav1.ListOptions{}); err != nil {
+		t.Fatalf("SearchWithOptions: %v", err)
+	}
+	if gotHeader != "mutated" {
+		t.Fatalf("X-Test header on the List request = %q, want %q", gotHeader, "mutated")
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/transport"
+)
+
+// wantsRequestOptions reports whether any of cfg's per-request REST
+// customizations -- User-Agent, priority hint, impersonation, timeout --
+// are set, i.e. whether CreateWithEventNamespaceOptions needs
+// createWithEventNamespaceAndRequestOptions instead of a plain create.
+func (c *createConfig) wantsRequestOptions() bool {
+	return c.userAgent != "" || c.priorityHint != "" || c.impersonateUser != "" || len(c.impersonateGroups) > 0 || c.timeout > 0
+}
+
+// createWithEventNamespaceAndRequestOptions behaves like
+// (*events).CreateWithEventNamespace, but applies cfg's User-Agent, priority
+// hint, impersonation and timeout to the same underlying request, so a
+// caller combining e.g. WithUserAgent and WithTimeout gets both instead of
+// whichever one a dispatcher happened to check first. Like the other
+// direct-REST-access helpers in this package (e.g.
+// CreateWithEventNamespaceTimeout), it only applies them when e is backed
+// by the generated *events client; for other EventInterface implementations
+// it falls back to an ordinary create, since there's no raw request to
+// customize.
+func createWithEventNamespaceAndRequestOptions(e EventInterface, event *v1.Event, cfg *createConfig) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.CreateWithEventNamespace(event)
+	}
+	if err := checkNamespaceMatch(impl.ns, event.Namespace, false, "create"); err != nil {
+		return nil, err
+	}
+	req := impl.client.Post().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource(eventResourceName()).
+		Body(event)
+	if cfg.userAgent != "" {
+		req = req.SetHeader("User-Agent", cfg.userAgent)
+	}
+	if cfg.priorityHint != "" {
+		req = req.SetHeader(PriorityHintHeader, cfg.priorityHint)
+	}
+	if cfg.impersonateUser != "" {
+		req = req.SetHeader(transport.ImpersonateUserHeader, cfg.impersonateUser)
+	}
+	if len(cfg.impersonateGroups) > 0 {
+		req = req.SetHeader(transport.ImpersonateGroupHeader, cfg.impersonateGroups...)
+	}
+	if cfg.timeout > 0 {
+		req = req.Timeout(cfg.timeout)
+	}
+	result := &v1.Event{}
+	err := req.Do(context.TODO()).Into(result)
+	return result, err
+}
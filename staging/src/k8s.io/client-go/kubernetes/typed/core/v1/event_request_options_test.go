@@ -0,0 +1,74 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/transport"
+)
+
+// TestCreateWithEventNamespaceOptionsCombinesRequestOptions guards against
+// the dispatcher in CreateWithEventNamespaceOptions picking only one of
+// WithUserAgent/WithPriorityHint/WithImpersonation/WithTimeout when several
+// are supplied together: every one of them must land on the same request,
+// not just whichever was checked first.
+func TestCreateWithEventNamespaceOptionsCombinesRequestOptions(t *testing.T) {
+	var gotUserAgent, gotPriorityHint, gotImpersonateUser, gotTimeout string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+		gotPriorityHint = r.Header.Get(PriorityHintHeader)
+		gotImpersonateUser = r.Header.Get(transport.ImpersonateUserHeader)
+		gotTimeout = r.URL.Query().Get("timeout")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event,
+		WithUserAgent("ctrl"),
+		WithPriorityHint("low"),
+		WithImpersonation("alice", []string{"system:masters"}),
+		WithTimeout(5*time.Second),
+	)
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+
+	if gotUserAgent != "ctrl" {
+		t.Errorf("User-Agent = %q, want %q", gotUserAgent, "ctrl")
+	}
+	if gotPriorityHint != "low" {
+		t.Errorf("%s = %q, want %q", PriorityHintHeader, gotPriorityHint, "low")
+	}
+	if gotImpersonateUser != "alice" {
+		t.Errorf("%s = %q, want %q", transport.ImpersonateUserHeader, gotImpersonateUser, "alice")
+	}
+	if gotTimeout != "5s" {
+		t.Errorf("timeout param = %q, want %q", gotTimeout, "5s")
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// EventResourceName is the REST resource name CreateWithEventNamespace,
+// UpdateWithEventNamespace, PatchWithEventNamespaceByName (and therefore
+// PatchWithEventNamespaceOfType) and their …Timeout variants target, in
+// place of the "events" those requests would otherwise hardcode. It exists
+// so a caller fronting this client with an aggregated API server that
+// exposes events under a different resource name isn't stuck
+// reimplementing these helpers from scratch; leaving it unset behaves
+// exactly like the hardcoded "events" every other path in this package
+// still uses.
+var EventResourceName = "events"
+
+// eventResourceName returns EventResourceName, falling back to "events" if
+// it's ever left or set to empty, so zeroing the variable can't produce a
+// pathless request.
+func eventResourceName() string {
+	if EventResourceName == "" {
+		return "events"
+	}
+	return EventResourceName
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// withEventResourceName overrides EventResourceName for the duration of a
+// test and restores the previous value on cleanup, the same way
+// withEventWriteMetricsRecorder manages EventWriteMetricsRecorder.
+func withEventResourceName(t *testing.T, name string) {
+	t.Helper()
+	prev := EventResourceName
+	EventResourceName = name
+	t.Cleanup(func() { EventResourceName = prev })
+}
+
+func TestCreateWithEventNamespaceUsesDefaultResourceName(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := e.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if gotPath != "/api/v1/namespaces/ns/events" {
+		t.Fatalf("path = %q, want /api/v1/namespaces/ns/events", gotPath)
+	}
+}
+
+func TestCreateWithEventNamespaceHonorsResourceNameOverride(t *testing.T) {
+	withEventResourceName(t, "customevents")
+
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	if _, err := e.CreateWithEventNamespace(event); err != nil {
+		t.Fatalf("CreateWithEventNamespace: %v", err)
+	}
+	if gotPath != "/api/v1/namespaces/ns/customevents" {
+		t.Fatalf("path = %q, want /api/v1/namespaces/ns/customevents", gotPath)
+	}
+}
+
+func TestPatchWithEventNamespaceByNameHonorsResourceNameOverride(t *testing.T) {
+	withEventResourceName(t, "customevents")
+
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	if _, err := PatchWithEventNamespaceByName(context.TODO(), e, "ns", "e1", types.StrategicMergePatchType, []byte(`{}`)); err != nil {
+		t.Fatalf("PatchWithEventNamespaceByName: %v", err)
+	}
+	if gotPath != "/api/v1/namespaces/ns/customevents/e1" {
+		t.Fatalf("path = %q, want /api/v1/namespaces/ns/customevents/e1", gotPath)
+	}
+}
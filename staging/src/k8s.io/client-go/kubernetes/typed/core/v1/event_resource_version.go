@@ -0,0 +1,61 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// GetFieldSelectorForResourceVersion returns a selector on
+// involvedObject.resourceVersion, or everything.Selector() if rv is nil.
+// Unlike the selectors GetFieldSelector builds, this one isn't combined with
+// any other involvedObject field by itself; callers that also want to scope
+// to a particular object should combine it with e.GetFieldSelector's result
+// via fields.AndSelectors, as SearchByObjectRevision does.
+func GetFieldSelectorForResourceVersion(rv *string) fields.Selector {
+	if rv == nil {
+		return fields.Everything()
+	}
+	return fields.OneTermEqualSelector("involvedObject.resourceVersion", *rv)
+}
+
+// SearchByObjectRevision searches for events about objOrRef recorded while
+// the object was at resource version rv, letting callers ask "what happened
+// to this object at the revision I have in hand" instead of seeing events
+// from every revision of its lifetime.
+func SearchByObjectRevision(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, rv string) (*v1.EventList, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	objSelector := e.GetFieldSelector(&objRef.Name, &objRef.Namespace, stringPtrIfSet(string(objRef.Kind)), stringPtrIfSet(string(objRef.UID)))
+	selector := fields.AndSelectors(objSelector, GetFieldSelectorForResourceVersion(&rv))
+	return e.List(context.TODO(), metav1.ListOptions{FieldSelector: selector.String()})
+}
+
+func stringPtrIfSet(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetFieldSelectorForResourceVersionNilIsEverything(t *testing.T) {
+	if got := GetFieldSelectorForResourceVersion(nil).String(); got != "" {
+		t.Errorf("GetFieldSelectorForResourceVersion(nil) = %q, want everything selector", got)
+	}
+}
+
+func TestGetFieldSelectorForResourceVersionSet(t *testing.T) {
+	want := "involvedObject.resourceVersion=42"
+	rv := "42"
+	if got := GetFieldSelectorForResourceVersion(&rv).String(); got != want {
+		t.Errorf("GetFieldSelectorForResourceVersion(&42) = %q, want %q", got, want)
+	}
+}
+
+func TestSearchByObjectRevisionCombinesObjectAndRevisionSelectors(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	list, err := SearchByObjectRevision(e, scheme, pod, "42")
+	if err != nil {
+		t.Fatalf("SearchByObjectRevision: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("len(list.Items) = %d, want 1", len(list.Items))
+	}
+	if !strings.Contains(gotFieldSelector, "involvedObject.name=p1") {
+		t.Errorf("fieldSelector = %q, want it to contain involvedObject.name=p1", gotFieldSelector)
+	}
+	if !strings.Contains(gotFieldSelector, "involvedObject.resourceVersion=42") {
+		t.Errorf("fieldSelector = %q, want it to contain involvedObject.resourceVersion=42", gotFieldSelector)
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchAndWatchRejectsUnreferenceableObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for an unreferenceable object")
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	if _, _, err := SearchAndWatch(context.Background(), e, scheme, pod); err == nil {
+		t.Fatalf("expected an error for an object the scheme doesn't recognize")
+	}
+}
+
+func TestSearchAndWatchPropagatesListError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	if _, _, err := SearchAndWatch(context.Background(), e, scheme, pod); err == nil {
+		t.Fatalf("expected the server's error to be propagated")
+	}
+}
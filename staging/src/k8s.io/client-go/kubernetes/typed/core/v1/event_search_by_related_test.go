@@ -0,0 +1,49 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestSearchByRelatedFiltersByAnnotation(t *testing.T) {
+	related := &v1.ObjectReference{Kind: "PersistentVolumeClaim", Namespace: "ns", Name: "data"}
+	want := relatedObjectAnnotationValue(related)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns","annotations":{"` + RelatedObjectAnnotation + `":"` + want + `"}}},
+			{"metadata":{"name":"e2","namespace":"ns"}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	list, err := SearchByRelated(context.Background(), e, related)
+	if err != nil {
+		t.Fatalf("SearchByRelated: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Fatalf("list.Items = %v, want [e1]", list.Items)
+	}
+}
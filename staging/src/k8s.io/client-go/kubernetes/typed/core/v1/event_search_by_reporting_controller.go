@@ -0,0 +1,38 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchByReportingController behaves like Search, but narrows the List call
+// server-side to events reported by reportingController, using
+// ReportingControllerFieldLabel so the field name is correct whether this
+// client talks to core/v1 ("reportingComponent") or events.k8s.io
+// ("reportingController"). Useful in multi-controller setups where several
+// components emit events about the same object and an unfiltered Search
+// drowns the one the caller cares about in the rest.
+func SearchByReportingController(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, version, reportingController string) (*v1.EventList, error) {
+	extra := fields.Set{ReportingControllerFieldLabel(version): reportingController}
+	return SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{FieldSelector: extra.AsSelector().String()})
+}
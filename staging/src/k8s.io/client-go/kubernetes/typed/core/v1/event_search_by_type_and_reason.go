@@ -0,0 +1,42 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchByTypeAndReason behaves like Search, but narrows the List call
+// server-side using the "type" and "reason" fields the apiserver indexes
+// for Events. An empty eventType or reason is omitted from the selector
+// entirely, rather than sent as e.g. "type=", so passing "" for one
+// narrows only on the other.
+func SearchByTypeAndReason(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, eventType, reason string) (*v1.EventList, error) {
+	extra := fields.Set{}
+	if eventType != "" {
+		extra["type"] = eventType
+	}
+	if reason != "" {
+		extra["reason"] = reason
+	}
+	return SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{FieldSelector: extra.AsSelector().String()})
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchByTypeAndReasonOmitsEmptyFields(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	if _, err := SearchByTypeAndReason(context.Background(), e, scheme, pod, "Warning", ""); err != nil {
+		t.Fatalf("SearchByTypeAndReason: %v", err)
+	}
+	if !strings.Contains(gotFieldSelector, "type=Warning") {
+		t.Fatalf("fieldSelector = %q, want it to contain type=Warning", gotFieldSelector)
+	}
+	if strings.Contains(gotFieldSelector, "reason=") {
+		t.Fatalf("fieldSelector = %q, want no reason term when reason is empty", gotFieldSelector)
+	}
+}
+
+func TestSearchByTypeAndReasonIncludesBothWhenSet(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	if _, err := SearchByTypeAndReason(context.Background(), e, scheme, pod, "Warning", "Killing"); err != nil {
+		t.Fatalf("SearchByTypeAndReason: %v", err)
+	}
+	if !strings.Contains(gotFieldSelector, "type=Warning") || !strings.Contains(gotFieldSelector, "reason=Killing") {
+		t.Fatalf("fieldSelector = %q, want both type=Warning and reason=Killing", gotFieldSelector)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// DefaultSearchByUIDsWorkers is the worker count SearchByUIDs uses when
+// callers pass workers <= 0.
+const DefaultSearchByUIDsWorkers = 4
+
+// GetFieldSelectorForUIDs returns a selector matching any one of uids,
+// optionally narrowed to involvedObjectNamespace. Field selectors don't
+// support an OR of values for the same field, so this can't be a single
+// fields.Selector the way GetFieldSelector's other terms are; instead it
+// returns one selector per uid, in the same order as uids, for the caller
+// to issue as separate List calls (SearchByUIDs does exactly that).
+func GetFieldSelectorForUIDs(involvedObjectNamespace *string, uids []string) []fields.Selector {
+	selectors := make([]fields.Selector, len(uids))
+	for i, uid := range uids {
+		uid := uid
+		field := fields.Set{"involvedObject.uid": uid}
+		if involvedObjectNamespace != nil {
+			field["involvedObject.namespace"] = *involvedObjectNamespace
+		}
+		selectors[i] = field.AsSelector()
+	}
+	return selectors
+}
+
+// SearchByUIDs lists events whose involvedObject.uid is any of uids,
+// optionally narrowed to involvedObjectNamespace, merging the results into a
+// single EventList sorted by event time (oldest first, using the same
+// EventTime/Series/LastTimestamp precedence as SearchSince) with duplicate
+// UIDs removed. Because field selectors can't express an OR, this issues one
+// List per uid, up to workers concurrently (workers <= 0 uses
+// DefaultSearchByUIDsWorkers); a failure on any one of them aborts the
+// others and is returned to the caller.
+func SearchByUIDs(ctx context.Context, e EventInterface, involvedObjectNamespace *string, uids []string, workers int) (*v1.EventList, error) {
+	if workers <= 0 {
+		workers = DefaultSearchByUIDsWorkers
+	}
+	selectors := GetFieldSelectorForUIDs(involvedObjectNamespace, uids)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lists := make([]*v1.EventList, len(selectors))
+	errs := make([]error, len(selectors))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				lists[idx], errs[idx] = e.List(ctx, metav1.ListOptions{FieldSelector: selectors[idx].String()})
+			}
+		}()
+	}
+	for i := range selectors {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	seen := map[string]bool{}
+	merged := &v1.EventList{}
+	for _, list := range lists {
+		for _, event := range list.Items {
+			key := string(event.UID)
+			if key == "" {
+				key = event.Namespace + "/" + event.Name
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged.Items = append(merged.Items, event)
+		}
+	}
+	sort.Slice(merged.Items, func(i, j int) bool {
+		return eventObservedTime(&merged.Items[i]).Before(eventObservedTime(&merged.Items[j]))
+	})
+	return merged, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchByUIDsMergesAndSortsByTime(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uid := r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch uid {
+		case "involvedObject.uid=u1":
+			w.Write([]byte(`{"items":[{"metadata":{"name":"e1","uid":"ev1"},"lastTimestamp":"2024-06-01T00:00:00Z"}]}`))
+		case "involvedObject.uid=u2":
+			w.Write([]byte(`{"items":[{"metadata":{"name":"e2","uid":"ev2"},"lastTimestamp":"2024-01-01T00:00:00Z"}]}`))
+		default:
+			t.Fatalf("unexpected fieldSelector %q", uid)
+		}
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	list, err := SearchByUIDs(context.Background(), e, nil, []string{"u1", "u2"}, 2)
+	if err != nil {
+		t.Fatalf("SearchByUIDs: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Name != "e2" || list.Items[1].Name != "e1" {
+		t.Fatalf("list.Items = %+v, want [e2 e1] (oldest first)", list.Items)
+	}
+}
+
+func TestSearchByUIDsDeduplicatesSameEventAcrossSelectors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"shared","uid":"evshared"},"lastTimestamp":"2024-01-01T00:00:00Z"}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	list, err := SearchByUIDs(context.Background(), e, nil, []string{"u1", "u2"}, 2)
+	if err != nil {
+		t.Fatalf("SearchByUIDs: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("list.Items = %+v, want exactly one deduplicated event", list.Items)
+	}
+}
+
+func TestGetFieldSelectorForUIDsIncludesNamespaceWhenSet(t *testing.T) {
+	ns := "ns1"
+	selectors := GetFieldSelectorForUIDs(&ns, []string{"u1"})
+	if len(selectors) != 1 {
+		t.Fatalf("len(selectors) = %d, want 1", len(selectors))
+	}
+	got := sortedFieldSelectorTerms(selectors[0].String())
+	want := sortedFieldSelectorTerms("involvedObject.uid=u1,involvedObject.namespace=ns1")
+	if got != want {
+		t.Fatalf("selectors[0] = %q, want %q", got, want)
+	}
+}
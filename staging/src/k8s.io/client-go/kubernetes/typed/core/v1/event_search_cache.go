@@ -0,0 +1,120 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// SearchCacheMetrics counts lookups against a SearchCache. Both fields
+// default to a no-op and can be replaced before the cache is used.
+type SearchCacheMetrics struct {
+	Hits   CounterMetric
+	Misses CounterMetric
+}
+
+type searchCacheEntry struct {
+	list      *v1.EventList
+	fetchedAt time.Time
+}
+
+// SearchCache is an opt-in, bounded, in-memory cache of Search results keyed
+// by the field selector Search would otherwise send to the server. It trades
+// freshness for load: a cached result can be up to TTL stale, so it is only
+// appropriate for read paths (dashboards, periodic summaries) that can
+// tolerate that, never for correctness-sensitive decisions like "has this
+// event already fired". The zero value is not ready to use; construct one
+// with NewSearchCache.
+type SearchCache struct {
+	e       EventInterface
+	ttl     time.Duration
+	maxSize int
+	metrics SearchCacheMetrics
+
+	mu      sync.Mutex
+	entries map[string]searchCacheEntry
+	order   []string
+}
+
+// NewSearchCache returns a SearchCache that serves Search results for e, each
+// good for ttl, evicting the oldest entry once more than maxSize field
+// selectors are cached.
+func NewSearchCache(e EventInterface, ttl time.Duration, maxSize int, metrics SearchCacheMetrics) *SearchCache {
+	if metrics.Hits == nil {
+		metrics.Hits = noopCounter{}
+	}
+	if metrics.Misses == nil {
+		metrics.Misses = noopCounter{}
+	}
+	return &SearchCache{
+		e:       e,
+		ttl:     ttl,
+		maxSize: maxSize,
+		metrics: metrics,
+		entries: make(map[string]searchCacheEntry),
+	}
+}
+
+// Search returns objOrRef's events, served from cache if a fetch within ttl
+// is on hand, else fetched fresh via the underlying EventInterface.Search and
+// cached for subsequent callers.
+func (c *SearchCache) Search(scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	// involvedObjectFieldSelector builds its string from a map, whose
+	// iteration order (and therefore the term order in the resulting
+	// selector string) isn't stable across calls even for the identical
+	// objRef; canonicalize before using it as a cache key, or two lookups
+	// for the same object could take different term orders and spuriously
+	// miss each other's cached entry.
+	key := canonicalizeFieldSelector(involvedObjectFieldSelector(c.e, objRef))
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	fresh := ok && time.Since(entry.fetchedAt) < c.ttl
+	c.mu.Unlock()
+	if fresh {
+		c.metrics.Hits.Inc()
+		return entry.list, nil
+	}
+	c.metrics.Misses.Inc()
+
+	list, err := c.e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[key]; !exists {
+		c.order = append(c.order, key)
+		for c.maxSize > 0 && len(c.order) > c.maxSize {
+			oldest := c.order[0]
+			c.order = c.order[1:]
+			delete(c.entries, oldest)
+		}
+	}
+	c.entries[key] = searchCacheEntry{list: list, fetchedAt: time.Now()}
+	return list, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchCacheServesFreshEntryFromCache(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	hits, misses := &countingMetric{}, &countingMetric{}
+	c := NewSearchCache(e, time.Minute, 10, SearchCacheMetrics{Hits: hits, Misses: misses})
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	if _, err := c.Search(scheme, pod); err != nil {
+		t.Fatalf("first Search: %v", err)
+	}
+	if _, err := c.Search(scheme, pod); err != nil {
+		t.Fatalf("second Search: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("server received %d requests, want 1 (second call should be served from cache)", got)
+	}
+	if hits.Count() != 1 {
+		t.Errorf("Hits = %d, want 1", hits.Count())
+	}
+	if misses.Count() != 1 {
+		t.Errorf("Misses = %d, want 1", misses.Count())
+	}
+}
+
+func TestSearchCacheRefetchesAfterTTLExpires(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	c := NewSearchCache(e, time.Millisecond, 10, SearchCacheMetrics{})
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+
+	if _, err := c.Search(scheme, pod); err != nil {
+		t.Fatalf("first Search: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := c.Search(scheme, pod); err != nil {
+		t.Fatalf("second Search: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("server received %d requests, want 2 (entry should have expired)", got)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ErrContinueTokenExpired is returned (wrapped, so errors.Is works) by
+// SearchEach when the server rejects a page's Continue token as expired
+// (HTTP 410 Gone), typically because the underlying watch cache compacted
+// past it while paging took too long.
+var ErrContinueTokenExpired = errors.New("event search continue token expired")
+
+// SearchEach pages through every event about objOrRef, pageSize items at a
+// time, calling fn once per event in server order. It stops and returns
+// fn's error the first time fn returns one, without fetching further pages.
+// An objOrRef with no matching events calls fn zero times and returns nil.
+func SearchEach(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, pageSize int64, fn func(*v1.Event) error) error {
+	continueToken := ""
+	for {
+		list, err := SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{
+			Limit:    pageSize,
+			Continue: continueToken,
+		})
+		if err != nil {
+			if apierrors.IsResourceExpired(err) {
+				return fmt.Errorf("%w: %v", ErrContinueTokenExpired, err)
+			}
+			return err
+		}
+		for i := range list.Items {
+			if err := fn(&list.Items[i]); err != nil {
+				return err
+			}
+		}
+		continueToken = list.Continue
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// EventShardPartitionLabel is the label SearchSharded adds to its list
+// request when SearchShardSelector is set, to restrict the read to a single
+// partition. It requires server-side support: an operator running a sharded
+// event store must be labeling events with this key at write time.
+const EventShardPartitionLabel = "events.k8s.io/shard"
+
+// SearchShardSelector, when non-nil, is consulted by SearchSharded to derive
+// a partition label from the involved object's UID. This is an opt-in hook
+// for very large clusters that shard event reads across multiple apiserver
+// paths by hashing the involved object UID; unset, SearchSharded behaves
+// exactly like Search, and Search itself is never affected by this hook.
+var SearchShardSelector func(uid types.UID) string
+
+// SearchAndWatch finds events about the specified object and returns a watch
+// started at the list's resourceVersion using the same involved-object
+// selector, so there is no gap or duplication between the snapshot and the
+// stream. It performs the same namespace validation as Search.
+func SearchAndWatch(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, watch.Interface, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	list, err := e.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, nil, fmt.Errorf("searching for events about %v: %w", objRef, err)
+	}
+	w, err := e.Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fieldSelector,
+		ResourceVersion: list.ResourceVersion,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return list, w, nil
+}
+
+// SearchSharded behaves like Search, except that when SearchShardSelector is
+// set it also restricts the List to the shard/partition the hook returns for
+// objRef's UID, to keep large-cluster event reads off a single apiserver
+// path.
+func SearchSharded(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	opts := metav1.ListOptions{FieldSelector: involvedObjectFieldSelector(e, objRef)}
+	if SearchShardSelector != nil {
+		shard := SearchShardSelector(objRef.UID)
+		opts.LabelSelector = labels.Set{EventShardPartitionLabel: shard}.AsSelector().String()
+	}
+	return e.List(ctx, opts)
+}
+
+// LatestForObject returns the single most recent event about objOrRef, or an
+// apierrors.NewNotFound-style error if there are none. It limits the list to
+// a small page so it doesn't pull the whole event history just to answer
+// "what happened last".
+func LatestForObject(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.Event, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	const latestPageSize = 25
+	list, err := e.List(ctx, metav1.ListOptions{
+		FieldSelector: involvedObjectFieldSelector(e, objRef),
+		Limit:         latestPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, apierrors.NewNotFound(v1.Resource("events"), "")
+	}
+	latest := &list.Items[0]
+	for i := range list.Items[1:] {
+		candidate := &list.Items[i+1]
+		if candidate.LastTimestamp.After(latest.LastTimestamp.Time) {
+			latest = candidate
+		}
+	}
+	return latest, nil
+}
+
+// SearchByRelated lists events in the client's namespace and returns those
+// carrying ref in their RelatedObjectAnnotation. Because annotations aren't
+// field-selectable, the filtering happens client-side after the List.
+func SearchByRelated(ctx context.Context, e EventInterface, ref *v1.ObjectReference) (*v1.EventList, error) {
+	list, err := e.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	want := relatedObjectAnnotationValue(ref)
+	matched := &v1.EventList{ListMeta: list.ListMeta}
+	for _, event := range list.Items {
+		if event.Annotations[RelatedObjectAnnotation] == want {
+			matched.Items = append(matched.Items, event)
+		}
+	}
+	return matched, nil
+}
+
+// SearchByCorrelationID lists events in the client's namespace and returns
+// those whose CorrelationIDAnnotation equals id, for joining events with
+// logs from the same traced request.
+func SearchByCorrelationID(ctx context.Context, e EventInterface, id string) (*v1.EventList, error) {
+	list, err := e.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	matched := &v1.EventList{ListMeta: list.ListMeta}
+	for _, event := range list.Items {
+		if event.Annotations[CorrelationIDAnnotation] == id {
+			matched.Items = append(matched.Items, event)
+		}
+	}
+	return matched, nil
+}
+
+// DistinctReasonsForObject returns the unique, sorted set of reasons seen
+// among events about objOrRef. It pages through all matching events but
+// accumulates only the reason strings, not full event bodies, so memory
+// stays bounded even over a long event history.
+func DistinctReasonsForObject(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) ([]string, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	seen := map[string]bool{}
+	continueToken := ""
+	for {
+		list, err := e.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector, Limit: eventExportPageSize, Continue: continueToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range list.Items {
+			if event.Reason != "" {
+				seen[event.Reason] = true
+			}
+		}
+		continueToken = list.Continue
+		if continueToken == "" {
+			break
+		}
+	}
+	reasons := make([]string, 0, len(seen))
+	for reason := range seen {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	return reasons, nil
+}
+
+// SearchWithinBudget finds events about objOrRef like Search, but derives a
+// sub-context bounded by budget and stops paging cleanly when that deadline
+// is hit, returning whatever was fetched so far plus truncated=true. This
+// keeps one slow object from blowing a dashboard's overall latency SLO when
+// it is searching events for many objects.
+func SearchWithinBudget(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, budget time.Duration) (list *v1.EventList, truncated bool, err error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, false, err
+	}
+	budgetCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	result := &v1.EventList{}
+	continueToken := ""
+	for {
+		page, err := e.List(budgetCtx, metav1.ListOptions{FieldSelector: fieldSelector, Limit: eventExportPageSize, Continue: continueToken})
+		if err != nil {
+			if budgetCtx.Err() != nil {
+				return result, true, nil
+			}
+			return result, false, err
+		}
+		result.Items = append(result.Items, page.Items...)
+		result.ResourceVersion = page.ResourceVersion
+		continueToken = page.Continue
+		if continueToken == "" {
+			return result, false, nil
+		}
+	}
+}
+
+// UnattributedReportingController is the bucket key SearchGroupedByController
+// uses for legacy, source-based events that carry no reportingController.
+const UnattributedReportingController = ""
+
+// SearchGroupedByController finds events about objOrRef and buckets them by
+// their ReportingController, so multi-controller debugging can see which
+// controller said what about an object. Events with no reportingController
+// (legacy source-based events) bucket under UnattributedReportingController.
+func SearchGroupedByController(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (map[string]*v1.EventList, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	grouped := map[string]*v1.EventList{}
+	for _, event := range list.Items {
+		bucket, ok := grouped[event.ReportingController]
+		if !ok {
+			bucket = &v1.EventList{}
+			grouped[event.ReportingController] = bucket
+		}
+		bucket.Items = append(bucket.Items, event)
+	}
+	return grouped, nil
+}
+
+// involvedObjectFieldSelector builds the field selector string that Search
+// uses for objRef, so the list-then-watch helpers can reuse it verbatim. It
+// relies on GetFieldSelector itself to drop any of these fields that are
+// empty (e.g. Kind/UID on a bare name+namespace reference) rather than
+// nil-ing them out here first.
+func involvedObjectFieldSelector(e EventInterface, objRef *v1.ObjectReference) string {
+	kind := objRef.Kind
+	uid := string(objRef.UID)
+	return e.GetFieldSelector(&objRef.Name, &objRef.Namespace, &kind, &uid).String()
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchGrouped finds events about objOrRef via Search and partitions them
+// into singles (Series is nil: one occurrence, or an old-style event that
+// predates event aggregation) and series (Series is non-nil: the Count-many
+// occurrences it represents have been coalesced server-side into one
+// object). Unlike SearchGroupedByController, which buckets by
+// ReportingController, this splits purely on whether an event is a
+// singleton or an aggregated series; the field selector and namespace guard
+// Search applies are unchanged.
+func SearchGrouped(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (singles []*v1.Event, series []*v1.Event, err error) {
+	list, err := SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range list.Items {
+		event := &list.Items[i]
+		if event.Series != nil {
+			series = append(series, event)
+		} else {
+			singles = append(singles, event)
+		}
+	}
+	return singles, series, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newGroupedTestServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+}
+
+func searchGroupedTestArgs(t *testing.T, srv *httptest.Server) (EventInterface, *runtime.Scheme, *v1.Pod) {
+	t.Helper()
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	return e, scheme, pod
+}
+
+func TestSearchGroupedEmptyList(t *testing.T) {
+	srv := newGroupedTestServer(t, `{"items":[]}`)
+	defer srv.Close()
+	e, scheme, pod := searchGroupedTestArgs(t, srv)
+
+	singles, series, err := SearchGrouped(context.Background(), e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchGrouped: %v", err)
+	}
+	if len(singles) != 0 || len(series) != 0 {
+		t.Fatalf("expected both groups empty, got singles=%v series=%v", singles, series)
+	}
+}
+
+func TestSearchGroupedAllSingles(t *testing.T) {
+	srv := newGroupedTestServer(t, `{"items":[
+		{"metadata":{"name":"e1","namespace":"ns"}},
+		{"metadata":{"name":"e2","namespace":"ns"}}
+	]}`)
+	defer srv.Close()
+	e, scheme, pod := searchGroupedTestArgs(t, srv)
+
+	singles, series, err := SearchGrouped(context.Background(), e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchGrouped: %v", err)
+	}
+	if len(singles) != 2 || len(series) != 0 {
+		t.Fatalf("singles=%d series=%d, want 2/0", len(singles), len(series))
+	}
+}
+
+func TestSearchGroupedMixed(t *testing.T) {
+	srv := newGroupedTestServer(t, `{"items":[
+		{"metadata":{"name":"single","namespace":"ns"}},
+		{"metadata":{"name":"aggregated","namespace":"ns"},"series":{"count":3,"lastObservedTime":"2024-01-01T00:00:00.000000Z"}}
+	]}`)
+	defer srv.Close()
+	e, scheme, pod := searchGroupedTestArgs(t, srv)
+
+	singles, series, err := SearchGrouped(context.Background(), e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchGrouped: %v", err)
+	}
+	if len(singles) != 1 || singles[0].Name != "single" {
+		t.Fatalf("singles = %v, want [single]", singles)
+	}
+	if len(series) != 1 || series[0].Name != "aggregated" {
+		t.Fatalf("series = %v, want [aggregated]", series)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// DefaultSearchManyWorkers is the worker count SearchMany uses when callers
+// pass workers <= 0.
+const DefaultSearchManyWorkers = 4
+
+// SearchMany runs Search for each of objsOrRefs, up to workers concurrently
+// (workers <= 0 uses DefaultSearchManyWorkers), and groups the results by
+// each object's involved-object UID. Unlike SearchByUIDs, a failure
+// resolving or searching for one object doesn't abort the others: the
+// returned map holds every object that succeeded, and every failure is
+// reported together via utilerrors.NewAggregate, so a caller can act on the
+// partial results while still seeing what went wrong. The namespace guard
+// Search itself applies is unaffected -- it's still enforced once per
+// object, inside the per-object Search call.
+func SearchMany(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objsOrRefs []runtime.Object, workers int) (map[types.UID]*v1.EventList, error) {
+	if workers <= 0 {
+		workers = DefaultSearchManyWorkers
+	}
+
+	type result struct {
+		uid  types.UID
+		list *v1.EventList
+		err  error
+	}
+	results := make([]result, len(objsOrRefs))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				objOrRef := objsOrRefs[idx]
+				objRef, err := referenceFor(scheme, objOrRef)
+				if err != nil {
+					results[idx] = result{err: fmt.Errorf("object %d: %w", idx, err)}
+					continue
+				}
+				list, err := SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{})
+				if err != nil {
+					results[idx] = result{err: fmt.Errorf("object %d (uid %q): %w", idx, objRef.UID, err)}
+					continue
+				}
+				results[idx] = result{uid: objRef.UID, list: list}
+			}
+		}()
+	}
+	for i := range objsOrRefs {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	grouped := map[types.UID]*v1.EventList{}
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		grouped[r.uid] = r.list
+	}
+	return grouped, utilerrors.NewAggregate(errs)
+}
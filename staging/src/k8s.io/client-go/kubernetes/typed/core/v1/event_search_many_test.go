@@ -0,0 +1,121 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSearchManyGroupsResultsByUID(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	pods := []runtime.Object{
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p2", Namespace: "ns", UID: "u2"}},
+	}
+
+	grouped, err := SearchMany(context.Background(), e, scheme, pods, 0)
+	if err != nil {
+		t.Fatalf("SearchMany: %v", err)
+	}
+	for _, uid := range []types.UID{"u1", "u2"} {
+		if _, ok := grouped[uid]; !ok {
+			t.Fatalf("expected a result for uid %q, got %v", uid, grouped)
+		}
+	}
+}
+
+func TestSearchManyReturnsPartialResultsAndAggregateError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	objs := []runtime.Object{
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}},
+		&appsv1.Deployment{}, // unresolvable: not registered in the core-v1-only scheme
+	}
+
+	grouped, err := SearchMany(context.Background(), e, scheme, objs, 0)
+	if err == nil {
+		t.Fatalf("expected an aggregate error for the unresolvable object")
+	}
+	if _, ok := grouped["u1"]; !ok {
+		t.Fatalf("expected the successful object's result to still be present, got %v", grouped)
+	}
+}
+
+func TestSearchManyBoundsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		for {
+			old := atomic.LoadInt32(&maxInFlight)
+			if cur <= old || atomic.CompareAndSwapInt32(&maxInFlight, old, cur) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	var objs []runtime.Object
+	for i := 0; i < 8; i++ {
+		objs = append(objs, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns", UID: types.UID(string(rune('a' + i)))}})
+	}
+
+	if _, err := SearchMany(context.Background(), e, scheme, objs, 2); err != nil {
+		t.Fatalf("SearchMany: %v", err)
+	}
+	if atomic.LoadInt32(&maxInFlight) > 2 {
+		t.Fatalf("maxInFlight = %d, want <= 2", maxInFlight)
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// SearchByObjectAllNamespaces behaves like Search, but deliberately omits the
+// involvedObject.namespace term from the field selector, so objOrRef's
+// name/kind/uid are matched against events across every namespace instead of
+// just this client's own -- useful for a cluster-admin debugging tool built
+// with the "" namespace client that doesn't know, or doesn't want to assume,
+// which namespace the events live in. Like SearchAllNamespaces, it only
+// works on a client built with the "" namespace; a namespaced client can't
+// satisfy "every namespace" and errors clearly instead of silently returning
+// a partial result.
+func SearchByObjectAllNamespaces(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	if impl, ok := e.(*events); ok && impl.ns != "" {
+		return nil, fmt.Errorf("SearchByObjectAllNamespaces requires a client built with the \"\" namespace, got %q", impl.ns)
+	}
+	fieldSelector := e.GetFieldSelector(&objRef.Name, nil, stringPtrIfSet(string(objRef.Kind)), stringPtrIfSet(string(objRef.UID)))
+	return e.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector.String()})
+}
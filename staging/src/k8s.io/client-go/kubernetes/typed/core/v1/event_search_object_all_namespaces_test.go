@@ -0,0 +1,72 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchByObjectAllNamespacesOmitsNamespaceTerm(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	if _, err := SearchByObjectAllNamespaces(context.Background(), e, scheme, pod); err != nil {
+		t.Fatalf("SearchByObjectAllNamespaces: %v", err)
+	}
+	if strings.Contains(gotFieldSelector, "involvedObject.namespace=") {
+		t.Fatalf("fieldSelector = %q, want no involvedObject.namespace term", gotFieldSelector)
+	}
+	if !strings.Contains(gotFieldSelector, "involvedObject.name=p1") {
+		t.Fatalf("fieldSelector = %q, want it to contain involvedObject.name=p1", gotFieldSelector)
+	}
+}
+
+func TestSearchByObjectAllNamespacesErrorsOnNamespacedClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be contacted for a namespaced client")
+	}))
+	defer srv.Close()
+
+	base := newTestEventsClient(t, srv)
+	scoped := &events{client: base.client, ns: "configured"}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	if _, err := SearchByObjectAllNamespaces(context.Background(), scoped, scheme, pod); err == nil {
+		t.Fatal("SearchByObjectAllNamespaces: want error for namespaced client")
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// SearchWithOptions finds events about objOrRef the way Search does, but
+// lets the caller tune the underlying List call: opts.Limit, opts.Continue,
+// opts.TimeoutSeconds and opts.ResourceVersion are forwarded as given, and a
+// caller-supplied opts.FieldSelector is ANDed with (not replaced by) the
+// object's own field selector, so narrowing by e.g. reason or type doesn't
+// lose the involvedObject match. Search itself is SearchWithOptions called
+// with an empty metav1.ListOptions.
+//
+// The returned *v1.EventList is never nil on a List-level failure (e.g. the
+// response body decodes only partway through): the generated List method
+// allocates it up front and decodes the response directly into it, so
+// whatever was already unmarshaled -- Items in particular -- comes back
+// alongside the error instead of being discarded. Only the guard checks
+// above the List call (an unresolvable objOrRef, or a namespace mismatch)
+// return a nil list, since there's no request, and therefore nothing
+// decoded, to return in those cases.
+func SearchWithOptions(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, opts metav1.ListOptions) (*v1.EventList, error) {
+	objRef, err := referenceFor(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	if impl, ok := e.(*events); ok && impl.ns != "" && objRef.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("won't be able to find any events of namespace '%v' in namespace '%v'", objRef.Namespace, impl.ns))
+	}
+	objSelector := involvedObjectFieldSelector(e, objRef)
+	opts.FieldSelector = andFieldSelectors(objSelector, opts.FieldSelector)
+	return e.List(ctx, opts)
+}
+
+// andFieldSelectors combines two field selector strings with a comma, the
+// field-selector syntax's AND operator, omitting either side if empty.
+func andFieldSelectors(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	default:
+		return a + "," + b
+	}
+}
+
+// referenceFor returns an ObjectReference for objOrRef, the way
+// ref.GetReference does, except that when objOrRef is already an
+// *v1.ObjectReference it's returned as-is instead of going through
+// GetReference. GetReference requires scheme to know objOrRef's Go type in
+// order to fill in Kind/APIVersion, which fails with an opaque "no kind is
+// registered" error for an object reference a caller already built by hand
+// and doesn't need the scheme to resolve.
+func referenceFor(scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.ObjectReference, error) {
+	if objRef, ok := objOrRef.(*v1.ObjectReference); ok {
+		return objRef, nil
+	}
+	return ref.GetReference(scheme, objOrRef)
+}
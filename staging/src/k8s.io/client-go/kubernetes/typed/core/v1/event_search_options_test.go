@@ -0,0 +1,141 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchWithOptionsCombinesFieldSelectors(t *testing.T) {
+	var gotFieldSelector, gotLimit, gotContinue string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		gotLimit = r.URL.Query().Get("limit")
+		gotContinue = r.URL.Query().Get("continue")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	_, err := SearchWithOptions(context.Background(), e, scheme, pod, metav1.ListOptions{
+		FieldSelector: "reason=Killing",
+		Limit:         50,
+		Continue:      "tok",
+	})
+	if err != nil {
+		t.Fatalf("SearchWithOptions: %v", err)
+	}
+
+	wantTerms := sortedFieldSelectorTerms(involvedObjectFieldSelector(e, &v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"}) + ",reason=Killing")
+	if sortedFieldSelectorTerms(gotFieldSelector) != wantTerms {
+		t.Fatalf("fieldSelector = %q, want terms %q", gotFieldSelector, wantTerms)
+	}
+	if gotLimit != "50" {
+		t.Fatalf("limit = %q, want 50", gotLimit)
+	}
+	if gotContinue != "tok" {
+		t.Fatalf("continue = %q, want tok", gotContinue)
+	}
+}
+
+func TestSearchDelegatesToSearchWithOptionsWithEmptyOpts(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	if _, err := e.Search(scheme, pod); err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	want := sortedFieldSelectorTerms(involvedObjectFieldSelector(e, &v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"}))
+	if sortedFieldSelectorTerms(gotFieldSelector) != want {
+		t.Fatalf("fieldSelector = %q, want terms %q", gotFieldSelector, want)
+	}
+}
+
+func TestSearchWithOptionsAcceptsRawObjectReferenceWithoutScheme(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	// An empty scheme: GetReference would fail on this with "no kind is
+	// registered" if referenceFor didn't special-case *v1.ObjectReference.
+	scheme := runtime.NewScheme()
+	objRef := &v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"}
+
+	if _, err := SearchWithOptions(context.Background(), e, scheme, objRef, metav1.ListOptions{}); err != nil {
+		t.Fatalf("SearchWithOptions: %v", err)
+	}
+
+	want := sortedFieldSelectorTerms(involvedObjectFieldSelector(e, objRef))
+	if sortedFieldSelectorTerms(gotFieldSelector) != want {
+		t.Fatalf("fieldSelector = %q, want terms %q", gotFieldSelector, want)
+	}
+}
+
+func TestSearchWithOptionsAcceptsRawObjectReferenceWithoutUID(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	objRef := &v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1"}
+
+	if _, err := SearchWithOptions(context.Background(), e, scheme, objRef, metav1.ListOptions{}); err != nil {
+		t.Fatalf("SearchWithOptions: %v", err)
+	}
+
+	want := sortedFieldSelectorTerms(involvedObjectFieldSelector(e, objRef))
+	if sortedFieldSelectorTerms(gotFieldSelector) != want {
+		t.Fatalf("fieldSelector = %q, want terms %q", gotFieldSelector, want)
+	}
+}
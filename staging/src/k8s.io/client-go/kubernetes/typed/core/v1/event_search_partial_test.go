@@ -0,0 +1,63 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TestSearchWithOptionsReturnsPartialListOnDecodeFailure guards the
+// underlying generated List method's behavior -- it allocates the
+// *v1.EventList up front and decodes the response directly into it, so a
+// response that fails partway through decoding still leaves whatever was
+// successfully unmarshaled (here, Items) in the object the caller gets
+// back, instead of Into discarding it. SearchWithOptions passes e.List's
+// return values straight through, so it must not turn that non-nil partial
+// list into a nil one along the way.
+func TestSearchWithOptionsReturnsPartialListOnDecodeFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		// Valid items array, followed by a field with the wrong type so the
+		// overall decode fails after Items has already been populated.
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}],"metadata":{"resourceVersion":12345}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	list, err := SearchWithOptions(context.Background(), e, scheme, pod, metav1.ListOptions{})
+	if err == nil {
+		t.Fatalf("expected a decode error from the malformed resourceVersion field")
+	}
+	if list == nil {
+		t.Fatalf("expected a non-nil partial list alongside the decode error")
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Fatalf("expected the already-decoded item to survive, got %+v", list.Items)
+	}
+}
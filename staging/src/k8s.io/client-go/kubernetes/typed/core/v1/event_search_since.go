@@ -0,0 +1,67 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SearchSince behaves like Search, but then keeps only the events most
+// recently observed at or after since, preserving the server's ordering.
+// The apiserver can't field-select on timestamps, so this issues the same
+// field-selected List Search does and filters the returned items
+// client-side: an event's relevant time is Series.LastObservedTime when
+// Series is set (it represents every occurrence up to that heartbeat),
+// EventTime when that's set, and LastTimestamp otherwise, for older events
+// that only ever populated the original firstTimestamp/lastTimestamp pair.
+// This means SearchSince still pays the cost of transferring every matching
+// event about objOrRef, not just the recent ones -- for a high-volume
+// object, prefer SearchWithOptions with a narrower field selector where
+// possible.
+func SearchSince(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, since time.Time) (*v1.EventList, error) {
+	list, err := SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	filtered := list.DeepCopy()
+	filtered.Items = filtered.Items[:0]
+	for _, event := range list.Items {
+		if !eventObservedTime(&event).Before(since) {
+			filtered.Items = append(filtered.Items, event)
+		}
+	}
+	return filtered, nil
+}
+
+// eventObservedTime returns the time event was most recently observed:
+// Series.LastObservedTime when event is part of a series, EventTime when
+// that's set, and LastTimestamp otherwise.
+func eventObservedTime(event *v1.Event) time.Time {
+	switch {
+	case event.Series != nil:
+		return event.Series.LastObservedTime.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.LastTimestamp.Time
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchSinceFiltersByLastTimestampWhenEventTimeUnset(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"old"},"lastTimestamp":"2024-01-01T00:00:00Z"},
+			{"metadata":{"name":"new"},"lastTimestamp":"2024-06-01T00:00:00Z"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	since, _ := time.Parse(time.RFC3339, "2024-03-01T00:00:00Z")
+	list, err := SearchSince(context.Background(), e, scheme, pod, since)
+	if err != nil {
+		t.Fatalf("SearchSince: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "new" {
+		t.Fatalf("list.Items = %+v, want only 'new'", list.Items)
+	}
+}
+
+func TestSearchSinceFiltersByEventTimeWhenSet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"old"},"eventTime":"2024-01-01T00:00:00.000000Z","lastTimestamp":"2024-06-01T00:00:00Z"},
+			{"metadata":{"name":"new"},"eventTime":"2024-06-01T00:00:00.000000Z"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	since, _ := time.Parse(time.RFC3339, "2024-03-01T00:00:00Z")
+	list, err := SearchSince(context.Background(), e, scheme, pod, since)
+	if err != nil {
+		t.Fatalf("SearchSince: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "new" {
+		t.Fatalf("list.Items = %+v, want only 'new' (eventTime takes precedence over lastTimestamp)", list.Items)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// nameFromSeed derives a stable, valid object name from an arbitrary seed
+// string, for callers whose seed isn't itself a legal name (e.g. it
+// contains characters outside the DNS subdomain charset, or is too long).
+func nameFromSeed(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return "seed-" + hex.EncodeToString(sum[:])
+}
+
+// CreateWithSeed derives event's name deterministically from seed and
+// creates it, so retrying the same logical create with the same seed is
+// idempotent: an AlreadyExists on the derived name is treated as success
+// and the existing event is returned instead of erroring. This relies on
+// the apiserver rejecting duplicate names within a namespace to provide the
+// exactly-once guarantee; it does not itself compare the existing event's
+// contents against event.
+func CreateWithSeed(ctx context.Context, e EventInterface, event *v1.Event, seed string) (*v1.Event, error) {
+	withName := *event
+	withName.Name = nameFromSeed(seed)
+
+	created, err := e.CreateWithEventNamespace(&withName)
+	if err == nil {
+		return created, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+	return e.Get(ctx, withName.Name, metav1.GetOptions{})
+}
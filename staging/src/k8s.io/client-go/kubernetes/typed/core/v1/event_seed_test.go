@@ -0,0 +1,88 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNameFromSeedIsStableAndValid(t *testing.T) {
+	a := nameFromSeed("controller-x/pod-1/backoff")
+	b := nameFromSeed("controller-x/pod-1/backoff")
+	if a != b {
+		t.Fatalf("nameFromSeed produced different names for the same seed: %q vs %q", a, b)
+	}
+	if nameFromSeed("other-seed") == a {
+		t.Errorf("nameFromSeed produced the same name for two different seeds")
+	}
+}
+
+func TestCreateWithSeedCreatesOnFirstCall(t *testing.T) {
+	var createdName string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		createdName = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"name":"` + nameFromSeed("seed-1") + `","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}}
+
+	result, err := CreateWithSeed(context.Background(), e, event, "seed-1")
+	if err != nil {
+		t.Fatalf("CreateWithSeed: %v", err)
+	}
+	if result.Name != nameFromSeed("seed-1") {
+		t.Errorf("result.Name = %q, want %q", result.Name, nameFromSeed("seed-1"))
+	}
+	if createdName == "" {
+		t.Errorf("expected the server to receive a create request")
+	}
+}
+
+func TestCreateWithSeedGetsExistingOnConflict(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"kind":"Status","status":"Failure","reason":"AlreadyExists","code":409}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"` + nameFromSeed("seed-2") + `","namespace":"ns"}}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}}
+
+	result, err := CreateWithSeed(context.Background(), e, event, "seed-2")
+	if err != nil {
+		t.Fatalf("CreateWithSeed: %v", err)
+	}
+	if result.Name != nameFromSeed("seed-2") {
+		t.Errorf("result.Name = %q, want %q", result.Name, nameFromSeed("seed-2"))
+	}
+}
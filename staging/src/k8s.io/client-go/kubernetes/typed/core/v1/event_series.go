@@ -0,0 +1,71 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultSeriesRetryBaseDelay = 10 * time.Millisecond
+	defaultSeriesRetrySteps     = 5
+)
+
+// ExtendSeriesWithRetry extends the series on the named event in namespace
+// ns, incrementing its count and bumping LastObservedTime to now. Unlike a
+// generic update-retry, it re-reads the event on a conflict and recomputes
+// the increment from the freshly-read object rather than blindly resending
+// the caller's copy, since the count it was holding may already be stale.
+// It retries with bounded backoff and returns the event with the
+// authoritative count.
+func ExtendSeriesWithRetry(ctx context.Context, e EventInterface, namespace, name string, now metav1.MicroTime) (*v1.Event, error) {
+	var result *v1.Event
+	backoff := wait.Backoff{Duration: defaultSeriesRetryBaseDelay, Factor: 2, Steps: defaultSeriesRetrySteps}
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		current, err := e.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		updated := current.DeepCopy()
+		if updated.Series == nil {
+			updated.Series = &v1.EventSeries{Count: 1, LastObservedTime: now}
+		} else {
+			updated.Series.Count++
+			updated.Series.LastObservedTime = now
+		}
+		saved, err := e.Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		result = saved
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// EventWithSeriesContext pairs an event with its effective series count and
+// first/last observed times, hiding the detail that a series's aggregate
+// state lives on the single representative event the apiserver keeps rather
+// than on each logical occurrence.
+type EventWithSeriesContext struct {
+	Event *v1.Event
+	// Count is Series.Count when the event is part of a series, or 1 for a
+	// singleton event.
+	Count int32
+	// FirstObserved is FirstTimestamp (or EventTime, for a series) of the
+	// event.
+	FirstObserved metav1.Time
+	// LastObserved is Series.LastObservedTime when part of a series, else
+	// LastTimestamp.
+	LastObserved metav1.Time
+}
+
+// SearchWithSeriesContext searches for events about objOrRef and annotates
+// each with its effective series count and first/last observed times,
+// hiding the series-vs-singleton split from UI code.
+func SearchWithSeriesContext(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) ([]EventWithSeriesContext, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]EventWithSeriesContext, 0, len(list.Items))
+	for i := range list.Items {
+		event := &list.Items[i]
+		ctx := EventWithSeriesContext{
+			Event:         event,
+			Count:         1,
+			FirstObserved: event.FirstTimestamp,
+			LastObserved:  event.LastTimestamp,
+		}
+		if event.Series != nil {
+			ctx.Count = event.Series.Count
+			ctx.LastObserved = metav1.Time{Time: event.Series.LastObservedTime.Time}
+			if ctx.FirstObserved.IsZero() {
+				ctx.FirstObserved = metav1.Time{Time: event.EventTime.Time}
+			}
+		}
+		results = append(results, ctx)
+	}
+	return results, nil
+}
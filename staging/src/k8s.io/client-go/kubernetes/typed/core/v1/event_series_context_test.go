@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchWithSeriesContextForSingletonEvent(t *testing.T) {
+	first := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	last := metav1.NewTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"},"firstTimestamp":"` + first.Format(time.RFC3339) + `","lastTimestamp":"` + last.Format(time.RFC3339) + `"}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	results, err := SearchWithSeriesContext(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchWithSeriesContext: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("len(results) = %d, want 1", len(results))
+	}
+	if results[0].Count != 1 {
+		t.Errorf("results[0].Count = %d, want 1 for a singleton event", results[0].Count)
+	}
+	if !results[0].FirstObserved.Time.Equal(first.Time) || !results[0].LastObserved.Time.Equal(last.Time) {
+		t.Errorf("results[0] first/last = %v/%v, want %v/%v", results[0].FirstObserved, results[0].LastObserved, first, last)
+	}
+}
+
+func TestSearchWithSeriesContextForSeriesEvent(t *testing.T) {
+	eventTime := metav1.NewMicroTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	lastObserved := metav1.NewMicroTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	microFormat := "2006-01-02T15:04:05.000000Z"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"},"eventTime":"` + eventTime.Format(microFormat) + `","series":{"count":5,"lastObservedTime":"` + lastObserved.Format(microFormat) + `"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	results, err := SearchWithSeriesContext(e, scheme, pod)
+	if err != nil {
+		t.Fatalf("SearchWithSeriesContext: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("len(results) = %d, want 1", len(results))
+	}
+	if results[0].Count != 5 {
+		t.Errorf("results[0].Count = %d, want 5 from Series.Count", results[0].Count)
+	}
+	if !results[0].LastObserved.Time.Equal(lastObserved.Time) {
+		t.Errorf("results[0].LastObserved = %v, want %v from Series.LastObservedTime", results[0].LastObserved, lastObserved)
+	}
+	if !results[0].FirstObserved.Time.Equal(eventTime.Time) {
+		t.Errorf("results[0].FirstObserved = %v, want %v from EventTime", results[0].FirstObserved, eventTime)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExtendSeriesWithRetryStartsANewSeries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"},"count":1}`))
+			return
+		}
+		var updated v1.Event
+		json.NewDecoder(r.Body).Decode(&updated)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(updated)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	now := metav1.NewMicroTime(metav1.Now().Time)
+
+	result, err := ExtendSeriesWithRetry(context.Background(), e, "ns", "e1", now)
+	if err != nil {
+		t.Fatalf("ExtendSeriesWithRetry: %v", err)
+	}
+	if result.Series == nil || result.Series.Count != 1 {
+		t.Fatalf("result.Series = %v, want a new series with count 1", result.Series)
+	}
+}
+
+func TestExtendSeriesWithRetryRecoversFromConflict(t *testing.T) {
+	var gets, updates int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			gets++
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"},"series":{"count":1}}`))
+			return
+		}
+		updates++
+		if updates == 1 {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"kind":"Status","status":"Failure","reason":"Conflict","code":409}`))
+			return
+		}
+		var updated v1.Event
+		json.NewDecoder(r.Body).Decode(&updated)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(updated)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	now := metav1.NewMicroTime(metav1.Now().Time)
+
+	result, err := ExtendSeriesWithRetry(context.Background(), e, "ns", "e1", now)
+	if err != nil {
+		t.Fatalf("ExtendSeriesWithRetry: %v", err)
+	}
+	if result.Series == nil || result.Series.Count != 2 {
+		t.Fatalf("result.Series = %v, want count 2 after the retried increment", result.Series)
+	}
+	if gets != 2 {
+		t.Errorf("gets = %d, want 2 (one per attempt)", gets)
+	}
+}
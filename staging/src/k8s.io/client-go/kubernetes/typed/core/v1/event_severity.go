@@ -0,0 +1,75 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// severityKeywords ranks reason substrings for DefaultSeverityClassifier,
+// most severe first.
+var severityKeywords = []struct {
+	substr string
+	score  int
+}{
+	{"Failed", 3},
+	{"Error", 3},
+	{"BackOff", 2},
+	{"Unhealthy", 2},
+	{"Killing", 1},
+}
+
+// DefaultSeverityClassifier scores an event by keywords in its reason
+// (Failed/Error rank highest, then BackOff/Unhealthy, then Killing), with
+// Warning-typed events otherwise scoring above Normal ones. It's the
+// default classifier for SearchBySeverity's "scariest events first" view.
+func DefaultSeverityClassifier(event *v1.Event) int {
+	for _, kw := range severityKeywords {
+		if strings.Contains(event.Reason, kw.substr) {
+			return kw.score
+		}
+	}
+	if event.Type == v1.EventTypeWarning {
+		return 1
+	}
+	return 0
+}
+
+// SearchBySeverity searches for events about objOrRef and sorts them by
+// classifier descending, breaking ties by recency (most recent first). The
+// sort is stable, so events with equal severity and timestamp keep their
+// original relative order.
+func SearchBySeverity(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, classifier func(*v1.Event) int) (*v1.EventList, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		si, sj := classifier(&list.Items[i]), classifier(&list.Items[j])
+		if si != sj {
+			return si > sj
+		}
+		return EventAge(&list.Items[i], now) < EventAge(&list.Items[j], now)
+	})
+	return list, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDefaultSeverityClassifierRanksByKeyword(t *testing.T) {
+	tests := []struct {
+		name  string
+		event *v1.Event
+		want  int
+	}{
+		{"failed reason", &v1.Event{Reason: "Failed"}, 3},
+		{"backoff reason", &v1.Event{Reason: "BackOff"}, 2},
+		{"killing reason", &v1.Event{Reason: "Killing"}, 1},
+		{"plain warning", &v1.Event{Type: v1.EventTypeWarning, Reason: "Scheduled"}, 1},
+		{"plain normal", &v1.Event{Type: v1.EventTypeNormal, Reason: "Scheduled"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultSeverityClassifier(tt.event); got != tt.want {
+				t.Errorf("DefaultSeverityClassifier(%+v) = %d, want %d", tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchBySeverityOrdersMostSevereFirst(t *testing.T) {
+	recent := metav1.NewTime(time.Now())
+	older := metav1.NewTime(time.Now().Add(-time.Hour))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"normal"},"type":"Normal","reason":"Scheduled","lastTimestamp":"` + recent.Format(time.RFC3339) + `"},
+			{"metadata":{"name":"failed"},"type":"Warning","reason":"Failed","lastTimestamp":"` + older.Format(time.RFC3339) + `"},
+			{"metadata":{"name":"backoff"},"type":"Warning","reason":"BackOff","lastTimestamp":"` + recent.Format(time.RFC3339) + `"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	list, err := SearchBySeverity(e, scheme, pod, DefaultSeverityClassifier)
+	if err != nil {
+		t.Fatalf("SearchBySeverity: %v", err)
+	}
+	got := []string{list.Items[0].Name, list.Items[1].Name, list.Items[2].Name}
+	want := []string{"failed", "backoff", "normal"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("order = %v, want %v", got, want)
+		}
+	}
+}
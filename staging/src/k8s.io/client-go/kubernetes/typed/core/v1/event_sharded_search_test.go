@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSearchShardedRestrictsToShardLabelWhenSelectorSet(t *testing.T) {
+	old := SearchShardSelector
+	defer func() { SearchShardSelector = old }()
+
+	var gotLabelSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLabelSelector = r.URL.Query().Get("labelSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	SearchShardSelector = func(uid types.UID) string { return "shard-3" }
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	if _, err := SearchSharded(context.Background(), e, scheme, pod); err != nil {
+		t.Fatalf("SearchSharded: %v", err)
+	}
+	if gotLabelSelector != EventShardPartitionLabel+"=shard-3" {
+		t.Errorf("labelSelector = %q, want %s=shard-3", gotLabelSelector, EventShardPartitionLabel)
+	}
+}
+
+func TestSearchShardedSkipsLabelSelectorWhenHookUnset(t *testing.T) {
+	old := SearchShardSelector
+	SearchShardSelector = nil
+	defer func() { SearchShardSelector = old }()
+
+	var sawLabelSelectorParam bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sawLabelSelectorParam = r.URL.Query().Has("labelSelector")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	if _, err := SearchSharded(context.Background(), e, scheme, pod); err != nil {
+		t.Fatalf("SearchSharded: %v", err)
+	}
+	if sawLabelSelectorParam {
+		t.Errorf("expected no labelSelector param when SearchShardSelector is nil")
+	}
+}
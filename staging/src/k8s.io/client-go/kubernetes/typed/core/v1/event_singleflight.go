@@ -0,0 +1,85 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// singleFlightCall tracks one in-flight Search shared by every caller keyed
+// to the same field selector; it is deleted from the group's map once done.
+type singleFlightCall struct {
+	wg     sync.WaitGroup
+	result *v1.EventList
+	err    error
+}
+
+// SingleFlightSearcher wraps Search so that concurrent calls for the same
+// involved object share one in-flight request and fan out the same result,
+// instead of each issuing its own List against the apiserver. This is
+// opt-in: callers sharing a collapsed call receive one snapshot of the
+// result (including any error), which is a slightly different consistency
+// guarantee than each goroutine getting its own independent read. Construct
+// one per client and reuse it across goroutines; the zero value is not
+// usable.
+type SingleFlightSearcher struct {
+	e EventInterface
+
+	mu    sync.Mutex
+	calls map[string]*singleFlightCall
+}
+
+// NewSingleFlightSearcher returns a SingleFlightSearcher backed by e.
+func NewSingleFlightSearcher(e EventInterface) *SingleFlightSearcher {
+	return &SingleFlightSearcher{e: e, calls: map[string]*singleFlightCall{}}
+}
+
+// Search behaves like EventInterface.Search, except that concurrent calls
+// for the same involved object (same field selector) are collapsed into one
+// request. All callers sharing a collapsed call receive the same
+// *v1.EventList and the same error.
+func (s *SingleFlightSearcher) Search(scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	key := involvedObjectFieldSelector(s.e, objRef)
+
+	s.mu.Lock()
+	if call, inFlight := s.calls[key]; inFlight {
+		s.mu.Unlock()
+		call.wg.Wait()
+		return call.result, call.err
+	}
+	call := &singleFlightCall{}
+	call.wg.Add(1)
+	s.calls[key] = call
+	s.mu.Unlock()
+
+	call.result, call.err = s.e.Search(scheme, objOrRef)
+
+	s.mu.Lock()
+	delete(s.calls, key)
+	s.mu.Unlock()
+	call.wg.Done()
+
+	return call.result, call.err
+}
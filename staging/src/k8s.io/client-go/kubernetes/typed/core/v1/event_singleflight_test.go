@@ -0,0 +1,120 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSingleFlightSearcherCollapsesConcurrentCalls(t *testing.T) {
+	var requests int32
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		entered <- struct{}{}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	// Use a bare ObjectReference (special-cased by ref.GetReference) with
+	// only Name set, so the derived field selector has a single term and
+	// its string form is deterministic across the two calls below; with
+	// more than one field.Set key, fields.Set.AsSelector's map iteration
+	// order isn't guaranteed to match between two separately-built
+	// selectors for the same object.
+	pod := &v1.ObjectReference{Name: "p1"}
+	searcher := NewSingleFlightSearcher(e)
+
+	var wg sync.WaitGroup
+	results := make([]*v1.EventList, 2)
+	errs := make([]error, 2)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], errs[0] = searcher.Search(scheme, pod)
+	}()
+
+	select {
+	case <-entered:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the first call to reach the server")
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[1], errs[1] = searcher.Search(scheme, pod)
+	}()
+	time.Sleep(20 * time.Millisecond) // give the second caller a chance to join the in-flight call
+	close(release)
+	wg.Wait()
+
+	if requests != 1 {
+		t.Fatalf("requests = %d, want 1 (the second call should have joined the first)", requests)
+	}
+	for i := range errs {
+		if errs[i] != nil {
+			t.Fatalf("searcher.Search()[%d]: %v", i, errs[i])
+		}
+	}
+	if results[0] != results[1] {
+		t.Errorf("results[0] != results[1], want callers sharing a collapsed call to get the same result")
+	}
+}
+
+func TestSingleFlightSearcherIssuesASecondRequestAfterTheFirstCompletes(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+	searcher := NewSingleFlightSearcher(e)
+
+	if _, err := searcher.Search(scheme, pod); err != nil {
+		t.Fatalf("searcher.Search: %v", err)
+	}
+	if _, err := searcher.Search(scheme, pod); err != nil {
+		t.Fatalf("searcher.Search: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2 once the first call has already completed", requests)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SnapshotOptions controls how SnapshotForObject normalizes events before
+// serializing them, so that two snapshots taken at different times can be
+// compared byte-for-byte in golden-file tests.
+type SnapshotOptions struct {
+	// ZeroTimestamps, when true, clears EventTime, FirstTimestamp and
+	// LastTimestamp (and any series LastObservedTime) before serializing.
+	ZeroTimestamps bool
+	// StripManagedFields, when true, clears ManagedFields before serializing.
+	StripManagedFields bool
+}
+
+// SnapshotForObject searches for events about objOrRef and serializes them to
+// a stable, sorted JSON format suitable for golden-file comparisons in tests.
+func SnapshotForObject(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, opts SnapshotOptions) ([]byte, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]v1.Event, len(list.Items))
+	copy(items, list.Items)
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+	for i := range items {
+		if opts.StripManagedFields {
+			items[i].ManagedFields = nil
+		}
+		if opts.ZeroTimestamps {
+			items[i].EventTime = metav1.MicroTime{}
+			items[i].FirstTimestamp = metav1.Time{}
+			items[i].LastTimestamp = metav1.Time{}
+			if items[i].Series != nil {
+				items[i].Series.LastObservedTime = metav1.MicroTime{}
+			}
+		}
+	}
+	return json.Marshal(items)
+}
+
+// RestoreFromSnapshot creates the events previously serialized by
+// SnapshotForObject, in order, against e. It is the inverse of
+// SnapshotForObject and is meant for seeding a fake apiserver in tests.
+func RestoreFromSnapshot(ctx context.Context, e EventInterface, snapshot []byte) error {
+	var items []v1.Event
+	if err := json.Unmarshal(snapshot, &items); err != nil {
+		return err
+	}
+	for i := range items {
+		if _, err := e.Create(ctx, &items[i], metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
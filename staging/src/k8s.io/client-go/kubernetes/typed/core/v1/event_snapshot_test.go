@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSnapshotForObjectSortsAndZeroesTimestamps(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"b","namespace":"ns"},"lastTimestamp":"2024-01-01T00:00:00Z"},
+			{"metadata":{"name":"a","namespace":"ns"},"lastTimestamp":"2024-01-02T00:00:00Z"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	data, err := SnapshotForObject(e, scheme, pod, SnapshotOptions{ZeroTimestamps: true})
+	if err != nil {
+		t.Fatalf("SnapshotForObject: %v", err)
+	}
+	var items []v1.Event
+	if err := json.Unmarshal(data, &items); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("len(items) = %d, want 2", len(items))
+	}
+	if items[0].Name != "a" || items[1].Name != "b" {
+		t.Errorf("items = [%s, %s], want sorted [a, b]", items[0].Name, items[1].Name)
+	}
+	if !items[0].LastTimestamp.IsZero() || !items[1].LastTimestamp.IsZero() {
+		t.Errorf("LastTimestamp not zeroed: %v, %v", items[0].LastTimestamp, items[1].LastTimestamp)
+	}
+}
+
+func TestRestoreFromSnapshotCreatesEventsInOrder(t *testing.T) {
+	var created []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e v1.Event
+		json.NewDecoder(r.Body).Decode(&e)
+		created = append(created, e.Name)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(e)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	snapshot, err := json.Marshal([]v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns"}},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	if err := RestoreFromSnapshot(context.Background(), e, snapshot); err != nil {
+		t.Fatalf("RestoreFromSnapshot: %v", err)
+	}
+	if len(created) != 2 || created[0] != "a" || created[1] != "b" {
+		t.Errorf("created = %v, want [a b]", created)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SortEventsByTime sorts list.Items in place by eventObservedTime (the same
+// Series.LastObservedTime > EventTime > LastTimestamp precedence SearchSince
+// and SearchByUIDs use), oldest first unless descending is true. Events
+// that tie on time sort by Name, so the order is deterministic regardless
+// of the order the apiserver returned them in.
+func SortEventsByTime(list *v1.EventList, descending bool) {
+	sort.Slice(list.Items, func(i, j int) bool {
+		ti, tj := eventObservedTime(&list.Items[i]), eventObservedTime(&list.Items[j])
+		if !ti.Equal(tj) {
+			if descending {
+				return ti.After(tj)
+			}
+			return ti.Before(tj)
+		}
+		return list.Items[i].Name < list.Items[j].Name
+	})
+}
+
+// SearchSorted finds events about objOrRef via Search and returns them
+// sorted by SortEventsByTime, so callers that only care about chronological
+// order don't need to sort the result themselves.
+func SearchSorted(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, descending bool) (*v1.EventList, error) {
+	list, err := SearchWithOptions(ctx, e, scheme, objOrRef, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	SortEventsByTime(list, descending)
+	return list, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSortEventsByTimeMixedOnlyLastTimestampSet(t *testing.T) {
+	t0 := metav1.NewTime(time.Unix(100, 0))
+	t1 := metav1.NewTime(time.Unix(200, 0))
+	list := &v1.EventList{Items: []v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "newer"}, LastTimestamp: t1},
+		{ObjectMeta: metav1.ObjectMeta{Name: "older"}, LastTimestamp: t0},
+	}}
+
+	SortEventsByTime(list, false)
+	if list.Items[0].Name != "older" || list.Items[1].Name != "newer" {
+		t.Fatalf("ascending order = %v, want [older, newer]", names(list))
+	}
+
+	SortEventsByTime(list, true)
+	if list.Items[0].Name != "newer" || list.Items[1].Name != "older" {
+		t.Fatalf("descending order = %v, want [newer, older]", names(list))
+	}
+}
+
+func TestSortEventsByTimeBreaksTiesByName(t *testing.T) {
+	same := metav1.NewTime(time.Unix(100, 0))
+	list := &v1.EventList{Items: []v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "b"}, LastTimestamp: same},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a"}, LastTimestamp: same},
+	}}
+
+	SortEventsByTime(list, false)
+	if names(list) != "[a b]" {
+		t.Fatalf("order = %v, want [a b]", names(list))
+	}
+}
+
+func TestSortEventsByTimePrefersSeriesOverLastTimestamp(t *testing.T) {
+	list := &v1.EventList{Items: []v1.Event{
+		{
+			ObjectMeta:    metav1.ObjectMeta{Name: "has-series"},
+			LastTimestamp: metav1.NewTime(time.Unix(0, 0)),
+			Series:        &v1.EventSeries{LastObservedTime: metav1.NewMicroTime(time.Unix(300, 0))},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "no-series"}, LastTimestamp: metav1.NewTime(time.Unix(100, 0))},
+	}}
+
+	SortEventsByTime(list, false)
+	if names(list) != "[no-series has-series]" {
+		t.Fatalf("order = %v, want [no-series has-series]", names(list))
+	}
+}
+
+func names(list *v1.EventList) string {
+	out := "["
+	for i, e := range list.Items {
+		if i > 0 {
+			out += " "
+		}
+		out += e.Name
+	}
+	return out + "]"
+}
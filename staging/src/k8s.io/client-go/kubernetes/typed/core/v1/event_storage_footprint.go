@@ -0,0 +1,61 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// StorageFootprintForObject pages through every event about objOrRef and
+// reports their total serialized size in bytes and their total occurrence
+// count (series occurrences included, not just the number of distinct
+// objects stored), for capacity planning against etcd/event-store growth.
+func StorageFootprintForObject(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (bytes int64, count int64, err error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return 0, 0, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+
+	var continueToken string
+	for {
+		page, err := e.List(ctx, metav1.ListOptions{
+			FieldSelector: fieldSelector,
+			Limit:         eventExportPageSize,
+			Continue:      continueToken,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		for i := range page.Items {
+			size, err := eventByteSize(&page.Items[i])
+			if err != nil {
+				return 0, 0, err
+			}
+			bytes += int64(size)
+			count += int64(occurrences(&page.Items[i]))
+		}
+		if page.Continue == "" {
+			return bytes, count, nil
+		}
+		continueToken = page.Continue
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestStorageFootprintForObjectPagesAndAggregates(t *testing.T) {
+	var pages int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("continue") == "" {
+			w.Write([]byte(`{"metadata":{"continue":"page2"},"items":[{"metadata":{"name":"e1","namespace":"ns"},"count":2}]}`))
+			return
+		}
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e2","namespace":"ns"},"count":3}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	bytes, count, err := StorageFootprintForObject(context.Background(), e, scheme, pod)
+	if err != nil {
+		t.Fatalf("StorageFootprintForObject: %v", err)
+	}
+	if pages != 2 {
+		t.Fatalf("pages = %d, want 2", pages)
+	}
+	if count != 5 {
+		t.Errorf("count = %d, want 5 (2 + 3 occurrences)", count)
+	}
+	if bytes <= 0 {
+		t.Errorf("bytes = %d, want > 0", bytes)
+	}
+}
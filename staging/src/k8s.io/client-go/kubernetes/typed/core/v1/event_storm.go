@@ -0,0 +1,50 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DetectStorm counts events about objOrRef within the recent window (summing
+// series counts, since a single series event can represent many logical
+// occurrences) and reports whether that count exceeds threshold. Controllers
+// can use this to back off their own event emission before they themselves
+// start contributing to the storm. The window check reuses EventAge so it
+// stays consistent with the rest of the package.
+func DetectStorm(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, threshold int, window time.Duration) (bool, int, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return false, 0, err
+	}
+	now := time.Now()
+	count := 0
+	for i := range list.Items {
+		event := &list.Items[i]
+		if EventAge(event, now) > window {
+			continue
+		}
+		if event.Series != nil {
+			count += int(event.Series.Count)
+		} else {
+			count++
+		}
+	}
+	return count > threshold, count, nil
+}
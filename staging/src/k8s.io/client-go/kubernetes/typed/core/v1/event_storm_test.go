@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDetectStormSumsSeriesCountsWithinWindow(t *testing.T) {
+	recent := metav1.NewTime(time.Now())
+	stale := metav1.NewTime(time.Now().Add(-time.Hour))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1"},"lastTimestamp":"` + recent.Format(time.RFC3339) + `","series":{"count":5}},
+			{"metadata":{"name":"e2"},"lastTimestamp":"` + recent.Format(time.RFC3339) + `"},
+			{"metadata":{"name":"e3"},"lastTimestamp":"` + stale.Format(time.RFC3339) + `","series":{"count":100}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	storming, count, err := DetectStorm(e, scheme, pod, 3, time.Minute)
+	if err != nil {
+		t.Fatalf("DetectStorm: %v", err)
+	}
+	if count != 6 {
+		t.Fatalf("count = %d, want 6 (5 + 1 within the window, stale entry excluded)", count)
+	}
+	if !storming {
+		t.Errorf("storming = false, want true when count exceeds threshold")
+	}
+}
+
+func TestDetectStormFalseWhenUnderThreshold(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"metadata":{"name":"e1"},"lastTimestamp":"` + metav1.NewTime(time.Now()).Format(time.RFC3339) + `"}]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	storming, count, err := DetectStorm(e, scheme, pod, 3, time.Minute)
+	if err != nil {
+		t.Fatalf("DetectStorm: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("count = %d, want 1", count)
+	}
+	if storming {
+		t.Errorf("storming = true, want false when count is under threshold")
+	}
+}
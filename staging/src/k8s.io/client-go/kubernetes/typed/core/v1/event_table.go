@@ -0,0 +1,54 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// FormatTable writes list to w as a kubectl-style table with columns Last
+// Seen, Type, Reason, Object, Message and Count, sorted most-recent-first,
+// so CLI tools don't each reimplement the column and age logic. The "Last
+// Seen" column uses EventAge for consistency with the rest of this package.
+func FormatTable(list *v1.EventList, w io.Writer) error {
+	items := make([]v1.Event, len(list.Items))
+	copy(items, list.Items)
+	now := time.Now()
+	sort.SliceStable(items, func(i, j int) bool {
+		return EventAge(&items[i], now) < EventAge(&items[j], now)
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\tCOUNT")
+	for i := range items {
+		event := &items[i]
+		object := event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+		count := event.Count
+		if event.Series != nil {
+			count = event.Series.Count
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			EventAge(event, now).Round(time.Second), event.Type, event.Reason, object, event.Message, count)
+	}
+	return tw.Flush()
+}
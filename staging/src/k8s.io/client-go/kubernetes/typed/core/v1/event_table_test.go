@@ -0,0 +1,69 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFormatTableOrdersMostRecentFirstAndPrefersSeriesCount(t *testing.T) {
+	recent := metav1.NewTime(time.Now())
+	older := metav1.NewTime(time.Now().Add(-time.Hour))
+	list := &v1.EventList{Items: []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "Failed",
+			Message:        "older event",
+			LastTimestamp:  older,
+			Count:          3,
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p2"},
+			Type:           v1.EventTypeNormal,
+			Reason:         "Scheduled",
+			Message:        "recent event",
+			LastTimestamp:  recent,
+			Series:         &v1.EventSeries{Count: 7},
+		},
+	}}
+
+	var buf bytes.Buffer
+	if err := FormatTable(list, &buf); err != nil {
+		t.Fatalf("FormatTable: %v", err)
+	}
+	out := buf.String()
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("len(lines) = %d, want 3 (header + 2 rows): %q", len(lines), out)
+	}
+	if !strings.Contains(lines[1], "p2") {
+		t.Errorf("row 1 = %q, want the most recent event (p2) first", lines[1])
+	}
+	if !strings.HasSuffix(strings.TrimRight(lines[1], " "), "7") {
+		t.Errorf("row 1 = %q, want count 7 taken from Series.Count", lines[1])
+	}
+	if !strings.Contains(lines[2], "p1") || !strings.HasSuffix(strings.TrimRight(lines[2], " "), "3") {
+		t.Errorf("row 2 = %q, want the older event (p1) with Count 3", lines[2])
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// placeholderPattern matches a named placeholder like "{{podName}}". The
+// name must be a simple identifier; anything else is a compile-time error so
+// a typo'd "{{pod name}}" or unterminated "{{pod" fails as soon as the
+// template is compiled, not the first time it's rendered in production.
+var placeholderPattern = regexp.MustCompile(`\{\{([A-Za-z_][A-Za-z0-9_]*)\}\}|\{\{[^}]*\}\}`)
+
+// EventTemplate is a message format string compiled once and rendered many
+// times with different values, so a controller's messages for a given
+// reason stay consistent and machine-parseable across emissions.
+type EventTemplate struct {
+	format       string
+	placeholders []string
+}
+
+// CompileEventTemplate parses format, validates its placeholder syntax, and
+// returns a reusable EventTemplate. Placeholders look like "{{name}}"; any
+// other use of "{{" is rejected here rather than surfacing as a missing
+// value at render time.
+func CompileEventTemplate(format string) (*EventTemplate, error) {
+	var placeholders []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(format, -1) {
+		if match[1] == "" {
+			return nil, fmt.Errorf("invalid placeholder syntax in event template %q", format)
+		}
+		placeholders = append(placeholders, match[1])
+	}
+	return &EventTemplate{format: format, placeholders: placeholders}, nil
+}
+
+// Render fills in t's placeholders from values and returns the resulting
+// message. It fails if any placeholder has no corresponding value; unused
+// entries in values are ignored.
+func (t *EventTemplate) Render(values map[string]string) (string, error) {
+	message := t.format
+	for _, name := range t.placeholders {
+		value, ok := values[name]
+		if !ok {
+			return "", fmt.Errorf("event template %q: missing value for placeholder %q", t.format, name)
+		}
+		message = strings.ReplaceAll(message, "{{"+name+"}}", value)
+	}
+	return message, nil
+}
+
+// BuilderCreate renders t with values into event's Message and creates it,
+// so callers get the rendered message back on event (for logging alongside
+// the create) without a separate Render call.
+func (t *EventTemplate) BuilderCreate(e EventInterface, event *v1.Event, values map[string]string) (*v1.Event, error) {
+	message, err := t.Render(values)
+	if err != nil {
+		return nil, err
+	}
+	withMessage := *event
+	withMessage.Message = message
+	return e.CreateWithEventNamespace(&withMessage)
+}
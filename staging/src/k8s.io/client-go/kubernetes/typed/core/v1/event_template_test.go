@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCompileEventTemplateRejectsInvalidPlaceholderSyntax(t *testing.T) {
+	if _, err := CompileEventTemplate("pod {{pod name}} failed"); err == nil {
+		t.Fatal("CompileEventTemplate: expected an error for a placeholder with a space")
+	}
+	if _, err := CompileEventTemplate("pod {{}} failed"); err == nil {
+		t.Fatal("CompileEventTemplate: expected an error for an empty placeholder")
+	}
+}
+
+func TestEventTemplateRenderFillsPlaceholders(t *testing.T) {
+	tmpl, err := CompileEventTemplate("pod {{podName}} failed with reason {{reason}}")
+	if err != nil {
+		t.Fatalf("CompileEventTemplate: %v", err)
+	}
+	got, err := tmpl.Render(map[string]string{"podName": "p1", "reason": "OOMKilled", "unused": "x"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := "pod p1 failed with reason OOMKilled"
+	if got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestEventTemplateRenderFailsOnMissingValue(t *testing.T) {
+	tmpl, err := CompileEventTemplate("pod {{podName}} failed")
+	if err != nil {
+		t.Fatalf("CompileEventTemplate: %v", err)
+	}
+	if _, err := tmpl.Render(map[string]string{}); err == nil {
+		t.Fatal("Render: expected an error for a missing placeholder value")
+	}
+}
+
+func TestBuilderCreateRendersMessageBeforeCreating(t *testing.T) {
+	var gotMessage string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e v1.Event
+		json.NewDecoder(r.Body).Decode(&e)
+		gotMessage = e.Message
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(e)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	tmpl, err := CompileEventTemplate("pod {{podName}} failed")
+	if err != nil {
+		t.Fatalf("CompileEventTemplate: %v", err)
+	}
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+
+	result, err := tmpl.BuilderCreate(e, event, map[string]string{"podName": "p1"})
+	if err != nil {
+		t.Fatalf("BuilderCreate: %v", err)
+	}
+	if result.Message != "pod p1 failed" {
+		t.Errorf("result.Message = %q, want %q", result.Message, "pod p1 failed")
+	}
+	if gotMessage != "pod p1 failed" {
+		t.Errorf("server received Message = %q, want %q", gotMessage, "pod p1 failed")
+	}
+}
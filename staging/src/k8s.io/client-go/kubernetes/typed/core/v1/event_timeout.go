@@ -0,0 +1,76 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// CreateWithEventNamespaceTimeout behaves like CreateWithEventNamespace, but
+// sets timeout as the request's server-side Timeout, so a struggling
+// apiserver returns a deadline-exceeded error well before the client's own
+// overall request timeout instead of stalling the caller's goroutine for the
+// full duration. Like the other direct-REST-access helpers in this package
+// (e.g. CreateWithEventNamespaceDryRun), it only applies when e is
+// backed by the generated *events client; for other EventInterface
+// implementations it falls back to an ordinary create, since there's no raw
+// request to attach a timeout to.
+func CreateWithEventNamespaceTimeout(e EventInterface, event *v1.Event, timeout time.Duration) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.CreateWithEventNamespace(event)
+	}
+	if impl.ns != "" && event.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't create an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns))
+	}
+	result := &v1.Event{}
+	err := impl.client.Post().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource(eventResourceName()).
+		Timeout(timeout).
+		Body(event).
+		Do(context.TODO()).
+		Into(result)
+	return result, err
+}
+
+// UpdateWithEventNamespaceTimeout is UpdateWithEventNamespace with a
+// server-side Timeout; see CreateWithEventNamespaceTimeout for the fallback
+// behavior when e isn't backed by the generated client.
+func UpdateWithEventNamespaceTimeout(e EventInterface, event *v1.Event, timeout time.Duration) (*v1.Event, error) {
+	impl, ok := e.(*events)
+	if !ok {
+		return e.UpdateWithEventNamespace(event)
+	}
+	if impl.ns != "" && event.Namespace != impl.ns {
+		return nil, newNamespaceMismatchError(fmt.Sprintf("can't update an event with namespace '%v' in namespace '%v'", event.Namespace, impl.ns))
+	}
+	result := &v1.Event{}
+	err := impl.client.Put().
+		NamespaceIfScoped(event.Namespace, len(event.Namespace) > 0).
+		Resource(eventResourceName()).
+		Name(event.Name).
+		Timeout(timeout).
+		Body(event).
+		Do(context.TODO()).
+		Into(result)
+	return result, err
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TraceContextAnnotation is the annotation WithTraceContext stamps onto a
+// created event, following the W3C Trace Context header name so a trace
+// span can be joined to the events emitted during it.
+const TraceContextAnnotation = "traceparent"
+
+// WithTraceContext stamps traceparent into TraceContextAnnotation on the
+// created event, so SearchByTrace can later find every event emitted during
+// that trace.
+func WithTraceContext(traceparent string) CreateOption {
+	return func(c *createConfig) {
+		c.traceparent = traceparent
+	}
+}
+
+// SearchByTrace lists events in e's namespace and returns only those
+// carrying traceparent in TraceContextAnnotation. Annotations aren't
+// field-selectable, so this filters client-side after an ordinary List.
+func SearchByTrace(ctx context.Context, e EventInterface, traceparent string) (*v1.EventList, error) {
+	list, err := e.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	filtered := list.DeepCopy()
+	filtered.Items = filtered.Items[:0]
+	for i := range list.Items {
+		if list.Items[i].Annotations[TraceContextAnnotation] == traceparent {
+			filtered.Items = append(filtered.Items, list.Items[i])
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWithTraceContextSetsConfigField(t *testing.T) {
+	cfg := &createConfig{}
+	WithTraceContext("00-trace-id-01")(cfg)
+	if cfg.traceparent != "00-trace-id-01" {
+		t.Errorf("cfg.traceparent = %q, want %q", cfg.traceparent, "00-trace-id-01")
+	}
+}
+
+func TestSearchByTraceFiltersByAnnotation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[
+			{"metadata":{"name":"e1","namespace":"ns","annotations":{"traceparent":"t1"}}},
+			{"metadata":{"name":"e2","namespace":"ns","annotations":{"traceparent":"t2"}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+
+	list, err := SearchByTrace(context.Background(), e, "t1")
+	if err != nil {
+		t.Fatalf("SearchByTrace: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "e1" {
+		t.Errorf("list.Items = %v, want only e1", list.Items)
+	}
+}
+
+func TestCreateWithEventNamespaceOptionsStampsTraceparent(t *testing.T) {
+	var gotAnnotations map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e v1.Event
+		json.NewDecoder(r.Body).Decode(&e)
+		gotAnnotations = e.Annotations
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(e)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "ns"}}
+	_, err := CreateWithEventNamespaceOptions(context.Background(), e, event, WithTraceContext("t1"))
+	if err != nil {
+		t.Fatalf("CreateWithEventNamespaceOptions: %v", err)
+	}
+	if gotAnnotations[TraceContextAnnotation] != "t1" {
+		t.Errorf("annotations = %v, want traceparent=t1", gotAnnotations)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// eventsResource is the GroupVersionResource for core/v1 events, used by the
+// dynamic path in SearchUnstructured.
+var eventsGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}
+
+// SearchUnstructured finds events about objOrRef via the dynamic client,
+// returning them as unstructured.Unstructured so schema-agnostic tooling can
+// query events without importing the typed API. It builds the same field
+// selector that the typed Search uses, via GetFieldSelector, so the two stay
+// in lockstep.
+func SearchUnstructured(ctx context.Context, e EventInterface, dynamicClient dynamic.Interface, objOrRef *v1.ObjectReference) (*unstructured.UnstructuredList, error) {
+	fieldSelector := involvedObjectFieldSelector(e, objOrRef)
+	resource := dynamicClient.Resource(eventsGVR)
+	if objOrRef.Namespace != "" {
+		return resource.Namespace(objOrRef.Namespace).List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	}
+	return resource.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+}
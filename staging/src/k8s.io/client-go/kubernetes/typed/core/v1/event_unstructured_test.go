@@ -0,0 +1,64 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newUnstructuredEvent(namespace, name, involvedObjectName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+			},
+			"involvedObject": map[string]interface{}{
+				"name": involvedObjectName,
+			},
+		},
+	}
+}
+
+func TestSearchUnstructuredListsInNamespace(t *testing.T) {
+	srv := httptest.NewServer(nil)
+	defer srv.Close()
+	e := newScopedTestEventsClient(t, srv, "ns")
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newUnstructuredEvent("ns", "e1", "p1"),
+		newUnstructuredEvent("other-ns", "e2", "p1"),
+	)
+
+	objRef := &v1.ObjectReference{Name: "p1", Namespace: "ns"}
+	list, err := SearchUnstructured(context.Background(), e, dynamicClient, objRef)
+	if err != nil {
+		t.Fatalf("SearchUnstructured: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].GetName() != "e1" {
+		t.Errorf("list.Items = %v, want only e1 from namespace ns", list.Items)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// UpdateMessage finds the event about objOrRef with the given reason and
+// patches only its message and series, for status-narrating controllers that
+// want to update the details of an ongoing condition without spamming a new
+// event per change. If no matching event exists, it creates one with
+// newMessage instead. It reuses the same find-then-act shape as
+// CreateIfAbsent and the same patch mechanics as PatchWithEventNamespace.
+func UpdateMessage(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, reason, newMessage string) (*v1.Event, error) {
+	existing, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	for i := range existing.Items {
+		candidate := &existing.Items[i]
+		if candidate.Reason != reason {
+			continue
+		}
+		now := metav1.NewTime(time.Now())
+		patch, err := json.Marshal(map[string]interface{}{
+			"message":       newMessage,
+			"lastTimestamp": now,
+			"count":         candidate.Count + 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return e.PatchWithEventNamespace(candidate, patch)
+	}
+
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	namespace := objRef.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	now := metav1.NewTime(time.Now())
+	return e.CreateWithEventNamespace(&v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", objRef.Name, now.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: *objRef,
+		Reason:         reason,
+		Message:        newMessage,
+		Type:           v1.EventTypeNormal,
+		Count:          1,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+	})
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestUpdateMessagePatchesExistingEventWithMatchingReason(t *testing.T) {
+	var patch map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"items":[{"metadata":{"name":"e1","namespace":"ns"},"reason":"Failed","count":2}]}`))
+		case http.MethodPatch:
+			json.NewDecoder(r.Body).Decode(&patch)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"metadata":{"name":"e1","namespace":"ns"},"reason":"Failed","message":"updated","count":3}`))
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	result, err := UpdateMessage(e, scheme, pod, "Failed", "updated")
+	if err != nil {
+		t.Fatalf("UpdateMessage: %v", err)
+	}
+	if result.Message != "updated" {
+		t.Errorf("result.Message = %q, want %q", result.Message, "updated")
+	}
+	if patch["message"] != "updated" {
+		t.Errorf("patch[message] = %v, want %q", patch["message"], "updated")
+	}
+	if patch["count"] != float64(3) {
+		t.Errorf("patch[count] = %v, want 3 (incremented from 2)", patch["count"])
+	}
+}
+
+func TestUpdateMessageCreatesWhenNoMatchingReason(t *testing.T) {
+	var created bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"items":[]}`))
+		case http.MethodPost:
+			created = true
+			var e v1.Event
+			json.NewDecoder(r.Body).Decode(&e)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(e)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+	result, err := UpdateMessage(e, scheme, pod, "Failed", "first failure")
+	if err != nil {
+		t.Fatalf("UpdateMessage: %v", err)
+	}
+	if !created {
+		t.Fatal("expected a create request when no matching event exists")
+	}
+	if result.Message != "first failure" || result.Count != 1 {
+		t.Errorf("result = %+v, want Message=%q Count=1", result, "first failure")
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// updateWithRetryBaseDelay is the first backoff duration
+// UpdateWithEventNamespaceRetry waits after a Conflict, doubling on each
+// subsequent retry, mirroring ExtendSeriesWithRetry's backoff shape.
+const updateWithRetryBaseDelay = 10 * time.Millisecond
+
+// UpdateWithEventNamespaceRetry updates event, retrying up to maxRetries
+// times with exponential backoff when the server reports a Conflict. On
+// each conflict it calls getLatest, copies the ResourceVersion it returns
+// onto a fresh copy of the caller's event, and retries the update with
+// that; the caller's other fields are resent unchanged. Any non-conflict
+// error, whether from the update or from getLatest, aborts immediately and
+// is returned to the caller.
+func UpdateWithEventNamespaceRetry(e EventInterface, event *v1.Event, getLatest func() (*v1.Event, error), maxRetries int) (*v1.Event, error) {
+	var result *v1.Event
+	attempt := event.DeepCopy()
+	backoff := wait.Backoff{Duration: updateWithRetryBaseDelay, Factor: 2, Steps: maxRetries + 1}
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		saved, err := e.UpdateWithEventNamespace(attempt)
+		if err == nil {
+			result = saved
+			return true, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return false, err
+		}
+		latest, getErr := getLatest()
+		if getErr != nil {
+			return false, getErr
+		}
+		attempt = event.DeepCopy()
+		attempt.ResourceVersion = latest.ResourceVersion
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
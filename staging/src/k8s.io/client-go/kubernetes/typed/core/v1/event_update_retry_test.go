@@ -0,0 +1,88 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateWithEventNamespaceRetrySucceedsAfterConflicts(t *testing.T) {
+	const wantConflicts = 2
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts <= wantConflicts {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Conflict","code":409}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"metadata":{"name":"e1","resourceVersion":"latest"}}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	getLatestCalls := 0
+	getLatest := func() (*v1.Event, error) {
+		getLatestCalls++
+		return &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", ResourceVersion: "fresh"}}, nil
+	}
+
+	result, err := UpdateWithEventNamespaceRetry(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1", ResourceVersion: "stale"}}, getLatest, 5)
+	if err != nil {
+		t.Fatalf("UpdateWithEventNamespaceRetry: %v", err)
+	}
+	if result.Name != "e1" {
+		t.Fatalf("result.Name = %q, want e1", result.Name)
+	}
+	if attempts != wantConflicts+1 {
+		t.Fatalf("attempts = %d, want %d", attempts, wantConflicts+1)
+	}
+	if getLatestCalls != wantConflicts {
+		t.Fatalf("getLatestCalls = %d, want %d", getLatestCalls, wantConflicts)
+	}
+}
+
+func TestUpdateWithEventNamespaceRetryAbortsOnNonConflictError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"InternalError","code":500}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	getLatestCalls := 0
+	getLatest := func() (*v1.Event, error) {
+		getLatestCalls++
+		return nil, errors.New("should not be called")
+	}
+
+	if _, err := UpdateWithEventNamespaceRetry(e, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}, getLatest, 5); err == nil {
+		t.Fatal("expected the non-conflict error to be returned")
+	}
+	if getLatestCalls != 0 {
+		t.Fatalf("getLatestCalls = %d, want 0", getLatestCalls)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateEventStrictReportsEveryProblem(t *testing.T) {
+	event := &v1.Event{
+		Type:    "Bogus",
+		Message: strings.Repeat("x", MaxEventMessageBytes+1),
+	}
+	errs := ValidateEventStrict(event)
+	if len(errs) != 5 {
+		t.Fatalf("len(errs) = %d, want 5 (kind, name, namespace, message, type): %v", len(errs), errs)
+	}
+}
+
+func TestValidateEventStrictAcceptsWellFormedEvent(t *testing.T) {
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1"},
+		Type:           v1.EventTypeNormal,
+	}
+	if errs := ValidateEventStrict(event); len(errs) != 0 {
+		t.Errorf("ValidateEventStrict() = %v, want no errors", errs)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// WaitForEvent blocks until an event about objOrRef satisfying match
+// appears, or ctx is done, returning ctx.Err() in the latter case. It first
+// lists the current events about the object to catch one that already
+// happened, then watches from that list's resourceVersion to catch ones
+// created afterward, so there is no gap in which a matching event could be
+// missed. This replaces the poll-and-list loops common in integration
+// tests.
+func WaitForEvent(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, match func(*v1.Event) bool) (*v1.Event, error) {
+	list, err := e.Search(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		if match(&list.Items[i]) {
+			return &list.Items[i], nil
+		}
+	}
+
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	watcher, err := e.Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fieldSelector,
+		ResourceVersion: list.ResourceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case evt, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, ctx.Err()
+			}
+			event, ok := evt.Object.(*v1.Event)
+			if !ok {
+				continue
+			}
+			if match(event) {
+				return event, nil
+			}
+		}
+	}
+}
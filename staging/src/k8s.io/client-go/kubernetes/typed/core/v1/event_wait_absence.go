@@ -0,0 +1,68 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// minWaitForNoEventsPollInterval floors the poll interval WaitForNoEvents
+// derives from debounce, so a very small debounce doesn't turn into a busy
+// loop against the apiserver.
+const minWaitForNoEventsPollInterval = 100 * time.Millisecond
+
+// WaitForNoEvents is the inverse of WaitForEvent: it blocks until objOrRef
+// has had no event matching filter for a continuous debounce window, or ctx
+// is done, returning ctx.Err() in the latter case. It polls Search rather
+// than watching, since an event already matching filter when the call is
+// made may never be followed by a new watch notification, and the debounce
+// window exists precisely to guard against that event reappearing a moment
+// later.
+func WaitForNoEvents(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, filter func(*v1.Event) bool, debounce time.Duration) error {
+	pollInterval := debounce / 10
+	if pollInterval < minWaitForNoEventsPollInterval {
+		pollInterval = minWaitForNoEventsPollInterval
+	}
+
+	var clearSince time.Time
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		list, err := e.Search(scheme, objOrRef)
+		if err != nil {
+			return false, err
+		}
+		for i := range list.Items {
+			if filter(&list.Items[i]) {
+				clearSince = time.Time{}
+				return false, nil
+			}
+		}
+		if clearSince.IsZero() {
+			clearSince = time.Now()
+			return false, nil
+		}
+		return time.Since(clearSince) >= debounce, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return ctx.Err()
+	}
+	return err
+}
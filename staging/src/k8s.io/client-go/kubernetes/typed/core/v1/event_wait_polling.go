@@ -0,0 +1,56 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WaitForEventPolling blocks until an event about objOrRef satisfying match
+// appears, or ctx is done, the same way WaitForEvent does, but by
+// repeatedly calling Search every pollInterval instead of opening a single
+// Watch. Prefer WaitForEvent, which only ever issues one List plus one
+// long-lived Watch; use this instead against an EventInterface whose Watch
+// support is unreliable (e.g. a proxy that doesn't support chunked/
+// streaming responses), where several short Search calls are preferable to
+// one watch connection that might silently stall.
+func WaitForEventPolling(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, match func(*v1.Event) bool, pollInterval time.Duration) (*v1.Event, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		list, err := e.Search(scheme, objOrRef)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			if match(&list.Items[i]) {
+				return &list.Items[i], nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// WriteAheadBufferMetrics counts activity on a WriteAheadBuffer. All fields
+// default to a no-op and can be replaced by a caller that wants to alert on
+// them.
+type WriteAheadBufferMetrics struct {
+	Buffered CounterMetric
+	Drained  CounterMetric
+	Dropped  CounterMetric
+}
+
+// WriteAheadBuffer persists events to disk when a create fails with a
+// retryable error, so they aren't lost across a transient apiserver outage.
+// It is bounded: once MaxEntries files are buffered, the oldest is evicted
+// (and WriteAheadBufferMetrics.Dropped incremented) to make room for the
+// new one. The zero value is not ready to use; construct one with
+// NewWriteAheadBuffer.
+type WriteAheadBuffer struct {
+	dir        string
+	maxEntries int
+	metrics    WriteAheadBufferMetrics
+
+	mu  sync.Mutex
+	seq uint64
+}
+
+// NewWriteAheadBuffer returns a WriteAheadBuffer that persists buffered
+// events as files under dir, capped at maxEntries. If dir already holds
+// entries from a previous process, new entries continue its sequence so
+// write order is preserved across restarts.
+func NewWriteAheadBuffer(dir string, maxEntries int, metrics WriteAheadBufferMetrics) (*WriteAheadBuffer, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	if metrics.Buffered == nil {
+		metrics.Buffered = noopCounter{}
+	}
+	if metrics.Drained == nil {
+		metrics.Drained = noopCounter{}
+	}
+	if metrics.Dropped == nil {
+		metrics.Dropped = noopCounter{}
+	}
+	b := &WriteAheadBuffer{dir: dir, maxEntries: maxEntries, metrics: metrics}
+	entries, err := b.sortedEntriesLocked()
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > 0 {
+		b.seq = entrySequence(entries[len(entries)-1]) + 1
+	}
+	return b, nil
+}
+
+// entrySequence extracts the leading sequence number from a buffer file
+// name of the form "<sequence>-<uuid>.json".
+func entrySequence(name string) uint64 {
+	prefix, _, ok := strings.Cut(name, "-")
+	if !ok {
+		return 0
+	}
+	seq, err := strconv.ParseUint(prefix, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return seq
+}
+
+// isRetryableCreateError reports whether err represents a transient
+// condition (server overload, timeout, or unavailability) worth buffering
+// and retrying, as opposed to a permanent rejection (e.g. invalid event)
+// that would just fail again on replay.
+func isRetryableCreateError(err error) bool {
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsInternalError(err)
+}
+
+// CreateWithBuffer creates event and, if that fails with a retryable error,
+// persists it to buf so a later DrainBuffer call can replay it once
+// connectivity returns. The original error is still returned either way, so
+// callers can log/alert on the outage; buffering is a safety net, not a
+// way to make the call appear to succeed.
+func CreateWithBuffer(e EventInterface, event *v1.Event, buf *WriteAheadBuffer) (*v1.Event, error) {
+	created, err := e.CreateWithEventNamespace(event)
+	if err == nil {
+		return created, nil
+	}
+	if buf != nil && isRetryableCreateError(err) {
+		if bufErr := buf.enqueue(event); bufErr != nil {
+			return nil, fmt.Errorf("create failed (%v) and buffering also failed: %w", err, bufErr)
+		}
+	}
+	return nil, err
+}
+
+func (b *WriteAheadBuffer) enqueue(event *v1.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entries, err := b.sortedEntriesLocked()
+	if err != nil {
+		return err
+	}
+	for b.maxEntries > 0 && len(entries) >= b.maxEntries {
+		oldest := entries[0]
+		entries = entries[1:]
+		if err := os.Remove(filepath.Join(b.dir, oldest)); err == nil {
+			b.metrics.Dropped.Inc()
+		}
+	}
+
+	name := fmt.Sprintf("%020d-%s.json", b.seq, uuid.NewUUID())
+	b.seq++
+	if err := os.WriteFile(filepath.Join(b.dir, name), data, 0o600); err != nil {
+		return err
+	}
+	b.metrics.Buffered.Inc()
+	return nil
+}
+
+// sortedEntriesLocked returns the buffer's file names in the order they
+// were written: each is named "<sequence>-<uuid>.json", so a lexicographic
+// sort of the zero-padded sequence number is also write order. Callers must
+// hold b.mu.
+func (b *WriteAheadBuffer) sortedEntriesLocked() ([]string, error) {
+	dirEntries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range dirEntries {
+		if entry.IsDir() {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// DrainBuffer replays every event currently buffered on disk, in the order
+// they were written, deleting each file as it's successfully recreated. It
+// stops at the first failure (leaving that event and everything after it
+// buffered for a future call) and returns the number successfully drained
+// so far alongside the error.
+func (b *WriteAheadBuffer) DrainBuffer(ctx context.Context, e EventInterface) (drained int, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entries, err := b.sortedEntriesLocked()
+	if err != nil {
+		return 0, err
+	}
+	for _, name := range entries {
+		path := filepath.Join(b.dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return drained, err
+		}
+		event := &v1.Event{}
+		if err := json.Unmarshal(data, event); err != nil {
+			return drained, err
+		}
+		if _, err := e.CreateWithEventNamespace(event); err != nil {
+			return drained, err
+		}
+		if err := os.Remove(path); err != nil {
+			return drained, err
+		}
+		b.metrics.Drained.Inc()
+		drained++
+		if err := ctx.Err(); err != nil {
+			return drained, err
+		}
+	}
+	return drained, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type recordingEventInterface struct {
+	EventInterface
+	created []*v1.Event
+}
+
+func (r *recordingEventInterface) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	r.created = append(r.created, event)
+	return event, nil
+}
+
+func TestWriteAheadBufferEnqueueAndDrain(t *testing.T) {
+	buf, err := NewWriteAheadBuffer(t.TempDir(), 0, WriteAheadBufferMetrics{})
+	if err != nil {
+		t.Fatalf("NewWriteAheadBuffer: %v", err)
+	}
+	events := []*v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	}
+	for _, e := range events {
+		if err := buf.enqueue(e); err != nil {
+			t.Fatalf("enqueue: %v", err)
+		}
+	}
+
+	sink := &recordingEventInterface{}
+	drained, err := buf.DrainBuffer(context.Background(), sink)
+	if err != nil {
+		t.Fatalf("DrainBuffer: %v", err)
+	}
+	if drained != 2 {
+		t.Fatalf("drained = %d, want 2", drained)
+	}
+	if len(sink.created) != 2 {
+		t.Fatalf("created %d events, want 2", len(sink.created))
+	}
+
+	// A second drain should find nothing left to replay.
+	drained, err = buf.DrainBuffer(context.Background(), sink)
+	if err != nil {
+		t.Fatalf("second DrainBuffer: %v", err)
+	}
+	if drained != 0 {
+		t.Fatalf("second drained = %d, want 0", drained)
+	}
+}
+
+func TestWriteAheadBufferEvictsOldestWhenFull(t *testing.T) {
+	buf, err := NewWriteAheadBuffer(t.TempDir(), 1, WriteAheadBufferMetrics{})
+	if err != nil {
+		t.Fatalf("NewWriteAheadBuffer: %v", err)
+	}
+	if err := buf.enqueue(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "first"}}); err != nil {
+		t.Fatalf("enqueue first: %v", err)
+	}
+	if err := buf.enqueue(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "second"}}); err != nil {
+		t.Fatalf("enqueue second: %v", err)
+	}
+
+	sink := &recordingEventInterface{}
+	drained, err := buf.DrainBuffer(context.Background(), sink)
+	if err != nil {
+		t.Fatalf("DrainBuffer: %v", err)
+	}
+	if drained != 1 {
+		t.Fatalf("drained = %d, want 1 (oldest should have been evicted)", drained)
+	}
+	if sink.created[0].Name != "second" {
+		t.Fatalf("drained event %q, want the most recently enqueued one", sink.created[0].Name)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// CreateAndWatchSubject creates event and returns a watch.Interface scoped
+// to objOrRef, started at a resourceVersion after the create, so the caller
+// immediately sees subsequent activity about the same object without a gap.
+// This is for a "take an action, then observe the reaction" pattern; it
+// reuses the same create and field-selector plumbing as
+// CreateWithEventNamespace and SearchAndWatch rather than duplicating it.
+func CreateAndWatchSubject(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, event *v1.Event) (*v1.Event, watch.Interface, error) {
+	created, err := e.CreateWithEventNamespace(event)
+	if err != nil {
+		return nil, nil, err
+	}
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := e.Watch(ctx, metav1.ListOptions{
+		FieldSelector:   involvedObjectFieldSelector(e, objRef),
+		ResourceVersion: created.ResourceVersion,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, w, nil
+}
+
+// WatchForObjectSplit runs a single underlying watch for events about
+// objOrRef and demultiplexes results onto two channels by their Type field,
+// so that callers needing separate Warning/Normal streams don't have to open
+// two watches. Both channels are closed when the underlying watch closes.
+func WatchForObjectSplit(ctx context.Context, e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object) (warnings, normals <-chan *v1.Event, err error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := e.Watch(ctx, metav1.ListOptions{FieldSelector: involvedObjectFieldSelector(e, objRef)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	warningCh := make(chan *v1.Event)
+	normalCh := make(chan *v1.Event)
+	go func() {
+		defer close(warningCh)
+		defer close(normalCh)
+		for evt := range w.ResultChan() {
+			event, ok := evt.Object.(*v1.Event)
+			if !ok {
+				continue
+			}
+			if event.Type == v1.EventTypeWarning {
+				warningCh <- event
+			} else {
+				normalCh <- event
+			}
+		}
+	}()
+	return warningCh, normalCh, nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+// WatchForObject opens a watch for events about objOrRef, using the same
+// field selector Search builds (via ref.GetReference + GetFieldSelector)
+// and the same namespace guard (an error when the object's namespace
+// doesn't match a non-empty e.ns), so new events about the object can be
+// streamed instead of polling Search in a loop.
+//
+// resourceVersion is passed through to the underlying Watch unchanged, so
+// "" starts from now, "0" means "most recent" per the usual apiserver
+// semantics, and any other value resumes a previously interrupted watch
+// from that point. AllowWatchBookmarks is always set, so a long-running
+// caller also receives periodic bookmark events (delivered to it like any
+// other event, unfiltered) to checkpoint a resourceVersion for next time.
+func WatchForObject(e EventInterface, scheme *runtime.Scheme, objOrRef runtime.Object, resourceVersion string) (watch.Interface, error) {
+	objRef, err := ref.GetReference(scheme, objOrRef)
+	if err != nil {
+		return nil, err
+	}
+	if impl, ok := e.(*events); ok && impl.ns != "" && objRef.Namespace != impl.ns {
+		return nil, fmt.Errorf("won't be able to find any events of namespace '%v' in namespace '%v'", objRef.Namespace, impl.ns)
+	}
+	fieldSelector := involvedObjectFieldSelector(e, objRef)
+	return e.Watch(context.TODO(), metav1.ListOptions{
+		FieldSelector:       fieldSelector,
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file lives in an external test package (v1_test), rather than v1
+// like the rest of this directory's tests, because it needs the generated
+// fake Clientset, which itself imports this package -- an internal test
+// file can't do that without creating an import cycle.
+package v1_test
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestWatchForObjectResumesFromGivenResourceVersion(t *testing.T) {
+	client := fakeclientset.NewSimpleClientset()
+
+	var gotResourceVersion string
+	seen := false
+	client.PrependWatchReactor("events", func(action kubetesting.Action) (bool, watch.Interface, error) {
+		wa := action.(kubetesting.WatchActionImpl)
+		gotResourceVersion = wa.GetWatchRestrictions().ResourceVersion
+		seen = true
+		return false, nil, nil
+	})
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	w, err := corev1.WatchForObject(client.CoreV1().Events("ns"), scheme, pod, "12345")
+	if err != nil {
+		t.Fatalf("WatchForObject: %v", err)
+	}
+	defer w.Stop()
+
+	if !seen {
+		t.Fatalf("expected the watch reactor to have observed a call")
+	}
+	if gotResourceVersion != "12345" {
+		t.Fatalf("ResourceVersion = %q, want 12345", gotResourceVersion)
+	}
+}
+
+func TestWatchForObjectDefaultsToEmptyResourceVersion(t *testing.T) {
+	client := fakeclientset.NewSimpleClientset()
+
+	var gotResourceVersion string
+	seen := false
+	client.PrependWatchReactor("events", func(action kubetesting.Action) (bool, watch.Interface, error) {
+		wa := action.(kubetesting.WatchActionImpl)
+		gotResourceVersion = wa.GetWatchRestrictions().ResourceVersion
+		seen = true
+		return false, nil, nil
+	})
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	w, err := corev1.WatchForObject(client.CoreV1().Events("ns"), scheme, pod, "")
+	if err != nil {
+		t.Fatalf("WatchForObject: %v", err)
+	}
+	defer w.Stop()
+
+	if !seen {
+		t.Fatalf("expected the watch reactor to have observed a call")
+	}
+	if gotResourceVersion != "" {
+		t.Fatalf("ResourceVersion = %q, want empty", gotResourceVersion)
+	}
+}
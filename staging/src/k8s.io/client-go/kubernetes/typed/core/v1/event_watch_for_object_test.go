@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// sortedFieldSelectorTerms splits a field selector string on its top-level
+// "," separator and sorts the terms, so two selectors built from the same
+// field:value pairs compare equal regardless of the map iteration order
+// fields.Set.AsSelector used to produce them.
+func sortedFieldSelectorTerms(selector string) string {
+	terms := strings.Split(selector, ",")
+	sort.Strings(terms)
+	return strings.Join(terms, ",")
+}
+
+func TestWatchForObjectUsesSameSelectorAsSearch(t *testing.T) {
+	var gotFieldSelector string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("watch") == "true" {
+			gotFieldSelector = r.URL.Query().Get("fieldSelector")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	e := newTestEventsClient(t, srv)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns", UID: "u1"}}
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+
+	searchList, err := e.Search(scheme, pod)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	_ = searchList
+
+	w, err := WatchForObject(e, scheme, pod, "")
+	if err != nil {
+		t.Fatalf("WatchForObject: %v", err)
+	}
+	w.Stop()
+
+	wantFieldSelector := involvedObjectFieldSelector(e, &v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: "p1", UID: "u1"})
+	if sortedFieldSelectorTerms(gotFieldSelector) != sortedFieldSelectorTerms(wantFieldSelector) {
+		t.Fatalf("fieldSelector = %q, want %q", gotFieldSelector, wantFieldSelector)
+	}
+}
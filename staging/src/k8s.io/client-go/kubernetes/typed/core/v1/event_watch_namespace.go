@@ -0,0 +1,112 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// watchNamespaceRetryDelay is how long WatchNamespace waits before retrying
+// after a watch fails to start, so a transient apiserver hiccup doesn't turn
+// into a busy loop.
+const watchNamespaceRetryDelay = time.Second
+
+// WatchNamespace watches every event in e's namespace and emits, on the
+// returned channel, those for which filter returns true. It transparently
+// reconnects whenever the underlying watch closes, whether from a normal
+// timeout or a "410 Gone" resourceVersion-too-old error; on a Gone error it
+// re-lists first and resumes watching from the fresh resourceVersion, so
+// events created during the reconnect aren't silently missed the way they
+// would be by just re-watching with the stale resourceVersion. The channel
+// is closed once ctx is done.
+func WatchNamespace(ctx context.Context, e EventInterface, filter func(*v1.Event) bool) (<-chan *v1.Event, error) {
+	list, err := e.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *v1.Event)
+	go runWatchNamespace(ctx, e, filter, list.ResourceVersion, out)
+	return out, nil
+}
+
+func runWatchNamespace(ctx context.Context, e EventInterface, filter func(*v1.Event) bool, resourceVersion string, out chan<- *v1.Event) {
+	defer close(out)
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		w, err := e.Watch(ctx, metav1.ListOptions{ResourceVersion: resourceVersion})
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchNamespaceRetryDelay):
+			}
+			continue
+		}
+		nextResourceVersion, gone := drainWatchNamespace(ctx, w, filter, out)
+		if gone {
+			if list, err := e.List(ctx, metav1.ListOptions{}); err == nil {
+				nextResourceVersion = list.ResourceVersion
+			}
+		}
+		if nextResourceVersion != "" {
+			resourceVersion = nextResourceVersion
+		}
+	}
+}
+
+// drainWatchNamespace consumes w until it closes or ctx is done, forwarding
+// events that pass filter to out. It returns the resourceVersion of the
+// last event observed (so the next watch can resume from it) and whether w
+// closed because the server reported the resourceVersion as expired.
+func drainWatchNamespace(ctx context.Context, w watch.Interface, filter func(*v1.Event) bool, out chan<- *v1.Event) (resourceVersion string, gone bool) {
+	defer w.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, false
+		case evt, ok := <-w.ResultChan():
+			if !ok {
+				return resourceVersion, false
+			}
+			if evt.Type == watch.Error {
+				return resourceVersion, apierrors.IsResourceExpired(apierrors.FromObject(evt.Object))
+			}
+			event, ok := evt.Object.(*v1.Event)
+			if !ok {
+				continue
+			}
+			resourceVersion = event.ResourceVersion
+			if !filter(event) {
+				continue
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return resourceVersion, false
+			}
+		}
+	}
+}
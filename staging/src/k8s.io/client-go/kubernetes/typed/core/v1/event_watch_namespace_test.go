@@ -0,0 +1,75 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestWatchNamespaceReturnsInitialListError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+
+	if _, err := WatchNamespace(context.Background(), e, func(*v1.Event) bool { return true }); err == nil {
+		t.Fatal("WatchNamespace: expected an error when the initial List fails")
+	}
+}
+
+// TestWatchNamespaceClosesChannelWhenContextIsDone exercises the reconnect
+// loop's ctx-cancellation path without asserting anything about in-flight
+// watch events: the handler closes every watch connection immediately, so
+// runWatchNamespace spins through reconnect attempts until ctx expires,
+// mirroring the httptest/watch-hang caution taken for the other watch-backed
+// helpers in this package (see SearchAndWatch's tests).
+func TestWatchNamespaceClosesChannelWhenContextIsDone(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("watch") != "true" {
+			w.Write([]byte(`{"items":[]}`))
+		}
+	}))
+	defer srv.Close()
+
+	e := newScopedTestEventsClient(t, srv, "ns")
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	out, err := WatchNamespace(ctx, e, func(*v1.Event) bool { return true })
+	if err != nil {
+		t.Fatalf("WatchNamespace: %v", err)
+	}
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("did not expect any events to be forwarded")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the output channel to close after ctx expired")
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -26,6 +28,13 @@ import (
 )
 
 func (c *FakeEvents) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	return c.CreateWithEventNamespaceContext(context.Background(), event)
+}
+
+// CreateWithEventNamespaceContext behaves like CreateWithEventNamespace; the
+// fake invokes its tracker synchronously, so ctx is accepted for interface
+// parity but not otherwise consulted.
+func (c *FakeEvents) CreateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (*v1.Event, error) {
 	action := core.NewRootCreateAction(eventsResource, event)
 	if c.ns != "" {
 		action = core.NewCreateAction(eventsResource, c.ns, event)
@@ -40,6 +49,12 @@ func (c *FakeEvents) CreateWithEventNamespace(event *v1.Event) (*v1.Event, error
 
 // Update replaces an existing event. Returns the copy of the event the server returns, or an error.
 func (c *FakeEvents) UpdateWithEventNamespace(event *v1.Event) (*v1.Event, error) {
+	return c.UpdateWithEventNamespaceContext(context.Background(), event)
+}
+
+// UpdateWithEventNamespaceContext behaves like UpdateWithEventNamespace; see
+// the CreateWithEventNamespaceContext comment about ctx.
+func (c *FakeEvents) UpdateWithEventNamespaceContext(ctx context.Context, event *v1.Event) (*v1.Event, error) {
 	action := core.NewRootUpdateAction(eventsResource, event)
 	if c.ns != "" {
 		action = core.NewUpdateAction(eventsResource, c.ns, event)
@@ -52,11 +67,21 @@ func (c *FakeEvents) UpdateWithEventNamespace(event *v1.Event) (*v1.Event, error
 	return obj.(*v1.Event), err
 }
 
-// PatchWithEventNamespace patches an existing event. Returns the copy of the event the server returns, or an error.
-// TODO: Should take a PatchType as an argument probably.
+// PatchWithEventNamespace patches an existing event using a strategic merge
+// patch. Returns the copy of the event the server returns, or an error.
 func (c *FakeEvents) PatchWithEventNamespace(event *v1.Event, data []byte) (*v1.Event, error) {
-	// TODO: Should be configurable to support additional patch strategies.
-	pt := types.StrategicMergePatchType
+	return c.PatchWithEventNamespaceContext(context.Background(), event, data)
+}
+
+// PatchWithEventNamespaceContext behaves like PatchWithEventNamespace; see
+// the CreateWithEventNamespaceContext comment about ctx.
+func (c *FakeEvents) PatchWithEventNamespaceContext(ctx context.Context, event *v1.Event, data []byte) (*v1.Event, error) {
+	return c.PatchWithEventNamespaceOfType(ctx, event, types.StrategicMergePatchType, data)
+}
+
+// PatchWithEventNamespaceOfType behaves like PatchWithEventNamespace but
+// with an explicit patch type.
+func (c *FakeEvents) PatchWithEventNamespaceOfType(ctx context.Context, event *v1.Event, pt types.PatchType, data []byte) (*v1.Event, error) {
 	action := core.NewRootPatchAction(eventsResource, event.Name, pt, data)
 	if c.ns != "" {
 		action = core.NewPatchAction(eventsResource, c.ns, event.Name, pt, data)
@@ -71,6 +96,12 @@ func (c *FakeEvents) PatchWithEventNamespace(event *v1.Event, data []byte) (*v1.
 
 // Search returns a list of events matching the specified object.
 func (c *FakeEvents) Search(scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
+	return c.SearchContext(context.Background(), scheme, objOrRef)
+}
+
+// SearchContext behaves like Search; see the
+// CreateWithEventNamespaceContext comment about ctx.
+func (c *FakeEvents) SearchContext(ctx context.Context, scheme *runtime.Scheme, objOrRef runtime.Object) (*v1.EventList, error) {
 	action := core.NewRootListAction(eventsResource, eventsKind, metav1.ListOptions{})
 	if c.ns != "" {
 		action = core.NewListAction(eventsResource, eventsKind, c.ns, metav1.ListOptions{})